@@ -0,0 +1,133 @@
+package calendar
+
+import (
+	"fmt"
+	"planner-microservice/units"
+	"time"
+
+	// zones are loaded offline, the service image has no zoneinfo
+	_ "time/tzdata"
+)
+
+// Clock is a time of day
+type Clock struct {
+	Hour   int
+	Minute int
+}
+
+// ParseClock parses a time of day written as "15:04"
+func ParseClock(value string) (Clock, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return Clock{}, fmt.Errorf("%q is not a time of day (15:04)", value)
+	}
+	return Clock{Hour: t.Hour(), Minute: t.Minute()}, nil
+}
+
+// Minutes returns the minutes from midnight to the time of day
+func (c Clock) Minutes() int {
+	return c.Hour*60 + c.Minute
+}
+
+// datetimeLayouts are the accepted forms of a local start datetime
+var datetimeLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// ParseStart parses the local datetime a plan starts at in an IANA time zone, UTC when zone is empty
+func ParseStart(datetime string, zone string) (time.Time, error) {
+	location, err := time.LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unknown time zone %q", zone)
+	}
+
+	for _, layout := range datetimeLayouts {
+		if start, err := time.ParseInLocation(layout, datetime, location); err == nil {
+			return start, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not a local datetime (2006-01-02T15:04:05)", datetime)
+}
+
+// Calendar maps the periods and blocks of a plan to datetimes
+type Calendar struct {
+	start       time.Time
+	period_unit units.Unit
+	block_unit  units.Unit
+	block_times []Clock // start of every block of a day, blocks follow each other when empty
+	week        Week
+}
+
+// New returns the calendar of a plan whose first period starts at start. Periods
+// of days only fall on the working days of week, their blocks start at
+// blockTimes when given.
+func New(start time.Time, periodUnit units.Unit, blockUnit units.Unit, blockTimes []Clock, week Week) (*Calendar, error) {
+	if periodUnit.IsZero() || blockUnit.IsZero() {
+		return nil, fmt.Errorf("a calendar needs a period unit and a block unit")
+	}
+	if periodUnit == units.Day && week.WorkingDays() == 0 {
+		return nil, fmt.Errorf("every day of the week is skipped")
+	}
+	if len(blockTimes) > 0 && periodUnit != units.Day {
+		return nil, fmt.Errorf("block start times only apply to periods of days")
+	}
+	for i := 1; i < len(blockTimes); i++ {
+		if blockTimes[i].Minutes() <= blockTimes[i-1].Minutes() {
+			return nil, fmt.Errorf("block start times must be increasing")
+		}
+	}
+
+	c := &Calendar{
+		start:       start,
+		period_unit: periodUnit,
+		block_unit:  blockUnit,
+		block_times: blockTimes,
+		week:        week,
+	}
+
+	// a plan of days starts on its first working day
+	if periodUnit == units.Day {
+		for !week.IsWorkingDay(c.start.Weekday()) {
+			c.start = c.start.AddDate(0, 0, 1)
+		}
+	}
+	return c, nil
+}
+
+// PeriodStart returns the datetime a period starts at
+func (c *Calendar) PeriodStart(period int) time.Time {
+	if c.period_unit != units.Day {
+		return c.period_unit.Add(c.start, period)
+	}
+
+	day := c.start
+	for i := 0; i < period; {
+		day = day.AddDate(0, 0, 1)
+		if c.week.IsWorkingDay(day.Weekday()) {
+			i++
+		}
+	}
+	return day
+}
+
+// BlockStart returns the datetime a block of a period starts at
+func (c *Calendar) BlockStart(period int, block int) time.Time {
+	start := c.PeriodStart(period)
+	if len(c.block_times) == 0 {
+		return c.block_unit.Add(start, block)
+	}
+
+	// the wall clock time of the block on the period's day,
+	// blocks past the last start time follow it
+	index := min(block, len(c.block_times)-1)
+	clock := c.block_times[index]
+	year, month, day := start.Date()
+	return c.block_unit.Add(time.Date(year, month, day, clock.Hour, clock.Minute, 0, 0, start.Location()), block-index)
+}
+
+// BlockEnd returns the datetime a block of a period ends at
+func (c *Calendar) BlockEnd(period int, block int) time.Time {
+	return c.block_unit.Add(c.BlockStart(period, block), 1)
+}
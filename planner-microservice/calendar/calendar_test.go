@@ -0,0 +1,186 @@
+package calendar
+
+import (
+	"planner-microservice/units"
+	"testing"
+	"time"
+)
+
+func mustStart(t *testing.T, datetime string, zone string) time.Time {
+	t.Helper()
+	start, err := ParseStart(datetime, zone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return start
+}
+
+func TestParseStart(t *testing.T) {
+	tests := []struct {
+		datetime string
+		zone     string
+		want     string
+		wantErr  bool
+	}{
+		{datetime: "2026-01-05T09:00:30", zone: "", want: "2026-01-05T09:00:30Z"},
+		{datetime: "2026-01-05T09:00", zone: "Europe/Berlin", want: "2026-01-05T09:00:00+01:00"},
+		{datetime: "2026-07-05", zone: "America/New_York", want: "2026-07-05T00:00:00-04:00"},
+		{datetime: "2026-01-05T09:00", zone: "Mars/Olympus", wantErr: true},
+		{datetime: "05/01/2026", zone: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		start, err := ParseStart(tt.datetime, tt.zone)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseStart(%q, %q) error = %v, want error %v", tt.datetime, tt.zone, err, tt.wantErr)
+			continue
+		}
+		if err == nil && start.Format(time.RFC3339) != tt.want {
+			t.Errorf("ParseStart(%q, %q) = %s, want %s", tt.datetime, tt.zone, start.Format(time.RFC3339), tt.want)
+		}
+	}
+}
+
+func TestParseClock(t *testing.T) {
+	if clock, err := ParseClock("09:30"); err != nil || clock.Minutes() != 570 {
+		t.Errorf("ParseClock(09:30) = %v, %v, want 570 minutes", clock, err)
+	}
+	for _, value := range []string{"9.30", "25:00", ""} {
+		if _, err := ParseClock(value); err == nil {
+			t.Errorf("ParseClock(%q) succeeded, want an error", value)
+		}
+	}
+}
+
+// blockStart is the RFC 3339 start wanted for a block of a period
+type blockStart struct {
+	period, block int
+	want          string
+}
+
+func TestCalendar(t *testing.T) {
+	weekend := []time.Weekday{time.Saturday, time.Sunday}
+	tests := []struct {
+		name       string
+		start      string
+		zone       string
+		period     units.Unit
+		block      units.Unit
+		blockTimes []string
+		skipped    []time.Weekday
+		blocks     []blockStart
+	}{
+		{
+			name:   "days keep the wall clock over the start of DST",
+			start:  "2026-03-28T09:00",
+			zone:   "Europe/Berlin",
+			period: units.Day,
+			block:  units.Hour,
+			blocks: []blockStart{
+				{0, 0, "2026-03-28T09:00:00+01:00"},
+				{1, 0, "2026-03-29T09:00:00+02:00"},
+				{1, 2, "2026-03-29T11:00:00+02:00"},
+			},
+		},
+		{
+			name:   "hours count elapsed time over the start of DST",
+			start:  "2026-03-29T01:00",
+			zone:   "Europe/Berlin",
+			period: units.Hour,
+			block:  units.Minute.Times(30),
+			blocks: []blockStart{
+				{1, 0, "2026-03-29T03:00:00+02:00"},
+				{0, 1, "2026-03-29T01:30:00+01:00"},
+			},
+		},
+		{
+			name:   "days keep the wall clock over the end of DST",
+			start:  "2026-10-24T08:00",
+			zone:   "America/New_York",
+			period: units.Day,
+			block:  units.Hour,
+			blocks: []blockStart{
+				{0, 0, "2026-10-24T08:00:00-04:00"},
+				{9, 0, "2026-11-02T08:00:00-05:00"},
+			},
+		},
+		{
+			name:    "skipped weekdays",
+			start:   "2026-01-03T09:00",
+			period:  units.Day,
+			block:   units.Hour,
+			skipped: weekend,
+			blocks: []blockStart{
+				{0, 0, "2026-01-05T09:00:00Z"},
+				{4, 0, "2026-01-09T09:00:00Z"},
+				{5, 1, "2026-01-12T10:00:00Z"},
+			},
+		},
+		{
+			name:       "block times",
+			start:      "2026-03-28",
+			zone:       "Europe/Berlin",
+			period:     units.Day,
+			block:      units.Hour,
+			blockTimes: []string{"09:00", "13:00"},
+			blocks: []blockStart{
+				{0, 0, "2026-03-28T09:00:00+01:00"},
+				{0, 1, "2026-03-28T13:00:00+01:00"},
+				{0, 3, "2026-03-28T15:00:00+01:00"},
+				{1, 1, "2026-03-29T13:00:00+02:00"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := mustStart(t, tt.start, tt.zone)
+			var blockTimes []Clock
+			for _, value := range tt.blockTimes {
+				clock, err := ParseClock(value)
+				if err != nil {
+					t.Fatal(err)
+				}
+				blockTimes = append(blockTimes, clock)
+			}
+
+			c, err := New(start, tt.period, tt.block, blockTimes, NewWeek(start.Weekday(), tt.skipped))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, b := range tt.blocks {
+				got := c.BlockStart(b.period, b.block)
+				if got.Format(time.RFC3339) != b.want {
+					t.Errorf("BlockStart(%d, %d) = %s, want %s", b.period, b.block, got.Format(time.RFC3339), b.want)
+				}
+				if end := c.BlockEnd(b.period, b.block); !end.After(got) {
+					t.Errorf("BlockEnd(%d, %d) = %s, want after %s", b.period, b.block, end, got)
+				}
+			}
+		})
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	start := mustStart(t, "2026-01-05T09:00", "")
+	nine := Clock{Hour: 9}
+	every := []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+
+	tests := []struct {
+		name       string
+		period     units.Unit
+		block      units.Unit
+		blockTimes []Clock
+		skipped    []time.Weekday
+	}{
+		{name: "no period unit", block: units.Hour},
+		{name: "every day skipped", period: units.Day, block: units.Hour, skipped: every},
+		{name: "block times of hours", period: units.Hour, block: units.Minute, blockTimes: []Clock{nine}},
+		{name: "decreasing block times", period: units.Day, block: units.Hour, blockTimes: []Clock{{Hour: 13}, nine}},
+		{name: "repeated block times", period: units.Day, block: units.Hour, blockTimes: []Clock{nine, nine}},
+	}
+	for _, tt := range tests {
+		if _, err := New(start, tt.period, tt.block, tt.blockTimes, NewWeek(start.Weekday(), tt.skipped)); err == nil {
+			t.Errorf("%s: New() succeeded, want an error", tt.name)
+		}
+	}
+}
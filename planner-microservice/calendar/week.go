@@ -0,0 +1,93 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// Weekdays maps the names of the days of the week to their value
+var Weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// ParseWeekday returns the day of the week of a lowercase name
+func ParseWeekday(name string) (time.Weekday, error) {
+	weekday, ok := Weekdays[name]
+	if !ok {
+		return 0, fmt.Errorf("%q is not a day of the week", name)
+	}
+	return weekday, nil
+}
+
+// Week places the periods of a plan of days on the working days of the week
+type Week struct {
+	start   time.Weekday
+	skipped [7]bool
+}
+
+// NewWeek returns the week of a plan whose first period is the first
+// working day from start, periods skip the days that aren't working days
+func NewWeek(start time.Weekday, skipped []time.Weekday) Week {
+	w := Week{start: start}
+	for _, day := range skipped {
+		w.skipped[day] = true
+	}
+
+	for range 7 {
+		if !w.skipped[w.start] {
+			break
+		}
+		w.start = (w.start + 1) % 7
+	}
+	return w
+}
+
+// Start returns the day of the week of the first period
+func (w Week) Start() time.Weekday {
+	return w.start
+}
+
+// IsWorkingDay reports whether periods can fall on a day of the week
+func (w Week) IsWorkingDay(day time.Weekday) bool {
+	return !w.skipped[day]
+}
+
+// WorkingDays returns the number of days of the week periods can fall on
+func (w Week) WorkingDays() int {
+	count := 0
+	for _, skipped := range w.skipped {
+		if !skipped {
+			count++
+		}
+	}
+	return count
+}
+
+// Recurrence returns the weekly recurrence of a routine on some days of the
+// week as the number of periods in a week and the offsets of the days in it
+func (w Week) Recurrence(days []time.Weekday) (int, []int) {
+	every := 0
+	position := make(map[time.Weekday]int, 7)
+	for i := range 7 {
+		day := (w.start + time.Weekday(i)) % 7
+		if w.skipped[day] {
+			continue
+		}
+		position[day] = every
+		every++
+	}
+
+	offsets := make([]int, 0, len(days))
+	for _, day := range days {
+		if offset, ok := position[day]; ok {
+			offsets = append(offsets, offset)
+		}
+	}
+	return every, offsets
+}
@@ -0,0 +1,74 @@
+package calendar
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNewWeek(t *testing.T) {
+	tests := []struct {
+		name        string
+		start       time.Weekday
+		skipped     []time.Weekday
+		wantStart   time.Weekday
+		workingDays int
+	}{
+		{name: "every day", start: time.Wednesday, wantStart: time.Wednesday, workingDays: 7},
+		{name: "starts on a weekend", start: time.Saturday, skipped: []time.Weekday{time.Saturday, time.Sunday}, wantStart: time.Monday, workingDays: 5},
+		{name: "wraps around", start: time.Friday, skipped: []time.Weekday{time.Friday, time.Saturday, time.Sunday, time.Monday}, wantStart: time.Tuesday, workingDays: 3},
+		{name: "every day skipped", start: time.Monday, skipped: []time.Weekday{0, 1, 2, 3, 4, 5, 6}, wantStart: time.Monday, workingDays: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWeek(tt.start, tt.skipped)
+			if w.Start() != tt.wantStart {
+				t.Errorf("Start() = %s, want %s", w.Start(), tt.wantStart)
+			}
+			if w.WorkingDays() != tt.workingDays {
+				t.Errorf("WorkingDays() = %d, want %d", w.WorkingDays(), tt.workingDays)
+			}
+			for _, day := range tt.skipped {
+				if w.IsWorkingDay(day) {
+					t.Errorf("IsWorkingDay(%s) = true for a skipped day", day)
+				}
+			}
+		})
+	}
+}
+
+func TestWeekRecurrence(t *testing.T) {
+	weekend := []time.Weekday{time.Saturday, time.Sunday}
+	tests := []struct {
+		name        string
+		start       time.Weekday
+		skipped     []time.Weekday
+		days        []time.Weekday
+		wantEvery   int
+		wantOffsets []int
+	}{
+		{name: "working days from monday", start: time.Monday, skipped: weekend, days: []time.Weekday{time.Monday, time.Thursday}, wantEvery: 5, wantOffsets: []int{0, 3}},
+		{name: "working days from wednesday", start: time.Wednesday, skipped: weekend, days: []time.Weekday{time.Monday, time.Friday}, wantEvery: 5, wantOffsets: []int{3, 2}},
+		{name: "skipped days are left out", start: time.Monday, skipped: weekend, days: []time.Weekday{time.Sunday, time.Tuesday}, wantEvery: 5, wantOffsets: []int{1}},
+		{name: "every day", start: time.Sunday, days: []time.Weekday{time.Saturday}, wantEvery: 7, wantOffsets: []int{6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			every, offsets := NewWeek(tt.start, tt.skipped).Recurrence(tt.days)
+			if every != tt.wantEvery || !slices.Equal(offsets, tt.wantOffsets) {
+				t.Errorf("Recurrence() = %d, %v, want %d, %v", every, offsets, tt.wantEvery, tt.wantOffsets)
+			}
+		})
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	if day, err := ParseWeekday("friday"); err != nil || day != time.Friday {
+		t.Errorf("ParseWeekday(friday) = %s, %v", day, err)
+	}
+	for _, name := range []string{"Friday", "fri", ""} {
+		if _, err := ParseWeekday(name); err == nil {
+			t.Errorf("ParseWeekday(%q) succeeded, want an error", name)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+// CSVContentType is the media type of a CSV document
+const CSVContentType = "text/csv; charset=utf-8"
+
+// CSV renders a plan with a row per block, periods and blocks are numbered
+// from 1 and start/end are RFC 3339 datetimes when the plan has a calendar
+func CSV(w io.Writer, plan Plan) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"period", "block", "start", "end", "type", "todo_id", "title", "pinned"}); err != nil {
+		return err
+	}
+
+	for i, period := range plan.Table {
+		for j, cell := range period {
+			var start, end string
+			if plan.Calendar != nil {
+				start = plan.Calendar.BlockStart(i, j).Format(time.RFC3339)
+				end = plan.Calendar.BlockEnd(i, j).Format(time.RFC3339)
+			}
+
+			record := []string{
+				strconv.Itoa(i + 1),
+				strconv.Itoa(j + 1),
+				start,
+				end,
+				cell.Type,
+				cell.TodoId,
+				plan.title(cell),
+				strconv.FormatBool(cell.Pinned),
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,75 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"planner-microservice/calendar"
+	"planner-microservice/units"
+	"strings"
+	"testing"
+)
+
+func TestCSV(t *testing.T) {
+	var out strings.Builder
+	if err := CSV(&out, samplePlan()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the title is quoted, so its comma doesn't split the row
+	if !strings.Contains(out.String(), `"Report, ""draft"" | <b>notes</b>"`) {
+		t.Errorf("the title isn't quoted:\n%s", out.String())
+	}
+
+	records, err := csv.NewReader(strings.NewReader(out.String())).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	title := samplePlan().Tasks[0].Title
+	want := [][]string{
+		{"period", "block", "start", "end", "type", "todo_id", "title", "pinned"},
+		{"1", "1", "", "", "routine", "gym", "Gym", "false"},
+		{"1", "2", "", "", "task", "report", title, "false"},
+		{"1", "3", "", "", "task", "report", title, "true"},
+		{"2", "1", "", "", "blocked", "", "Blocked", "false"},
+		{"2", "2", "", "", "free", "", "Free", "false"},
+		{"2", "3", "", "", "task", "report", title, "false"},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("CSV() has %d rows, want %d:\n%s", len(records), len(want), out.String())
+	}
+	for i := range want {
+		if strings.Join(records[i], "|") != strings.Join(want[i], "|") {
+			t.Errorf("row %d = %q, want %q", i, records[i], want[i])
+		}
+	}
+}
+
+func TestCSVWithCalendar(t *testing.T) {
+	start, err := calendar.ParseStart("2026-03-28T09:00", "Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan := samplePlan()
+	plan.Calendar, err = calendar.New(start, units.Day, units.Hour, nil, calendar.NewWeek(start.Weekday(), nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out strings.Builder
+	if err := CSV(&out, plan); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(strings.NewReader(out.String())).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the second period starts after DST does
+	for row, want := range map[int][2]string{
+		1: {"2026-03-28T09:00:00+01:00", "2026-03-28T10:00:00+01:00"},
+		6: {"2026-03-29T11:00:00+02:00", "2026-03-29T12:00:00+02:00"},
+	} {
+		if got := [2]string{records[row][2], records[row][3]}; got != want {
+			t.Errorf("row %d starts and ends at %q, want %q", row, got, want)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package exporter
+
+import (
+	"fmt"
+	"planner-microservice/calendar"
+	"planner-microservice/planner"
+	"planner-microservice/units"
+)
+
+// Plan is a generated table with the todos and the calendar needed to render it
+type Plan struct {
+	Table     [][]planner.TableCell
+	Tasks     []planner.Task
+	Routines  []planner.Routine
+	BuildUnit units.Unit         // length of a block, durations are counted in blocks when zero
+	Calendar  *calendar.Calendar // nil when the plan has no start datetime
+}
+
+// todo returns the task or routine a cell belongs to
+func (p Plan) todo(cell planner.TableCell) (planner.Todo, bool) {
+	switch cell.Type {
+	case "task":
+		for _, task := range p.Tasks {
+			if task.Id == cell.TodoId {
+				return task.Todo, true
+			}
+		}
+	case "routine":
+		for _, routine := range p.Routines {
+			if routine.Id == cell.TodoId {
+				return routine.Todo, true
+			}
+		}
+	}
+	return planner.Todo{}, false
+}
+
+// run is a sequence of consecutive blocks of the same todo in a period
+type run struct {
+	period int
+	block  int // first block
+	length int
+	cell   planner.TableCell
+}
+
+// runs returns the consecutive blocks of the same todo in every period,
+// free and blocked cells are left out. With a calendar, blocks are only
+// consecutive when one ends where the next starts.
+func (p Plan) runs() []run {
+	var runs []run
+	for i, period := range p.Table {
+		for j, cell := range period {
+			if cell.Type != "task" && cell.Type != "routine" {
+				continue
+			}
+
+			if n := len(runs); n > 0 {
+				last := &runs[n-1]
+				if last.period == i && last.block+last.length == j &&
+					last.cell.Type == cell.Type && last.cell.TodoId == cell.TodoId &&
+					(p.Calendar == nil || p.Calendar.BlockEnd(i, j-1).Equal(p.Calendar.BlockStart(i, j))) {
+					last.length++
+					continue
+				}
+			}
+			runs = append(runs, run{period: i, block: j, length: 1, cell: cell})
+		}
+	}
+	return runs
+}
+
+// title returns the title of a cell's todo, or what the cell is when it has none
+func (p Plan) title(cell planner.TableCell) string {
+	if todo, ok := p.todo(cell); ok {
+		return todo.Title
+	}
+	switch cell.Type {
+	case "blocked":
+		return "Blocked"
+	case "free":
+		return "Free"
+	}
+	return cell.TodoId
+}
+
+// periodLabel names a period by its date, or by its number without a calendar
+func (p Plan) periodLabel(period int) string {
+	if p.Calendar == nil {
+		return fmt.Sprintf("Period %d", period+1)
+	}
+	return p.Calendar.PeriodStart(period).Format("Monday, January 2, 2006")
+}
+
+// timeRange returns the local start and end times of a run of blocks, empty without a calendar
+func (p Plan) timeRange(period int, block int, length int) string {
+	if p.Calendar == nil {
+		return ""
+	}
+	start := p.Calendar.BlockStart(period, block)
+	end := p.Calendar.BlockEnd(period, block+length-1)
+	return start.Format("15:04") + "–" + end.Format("15:04")
+}
+
+// duration writes a number of blocks in the plan's build unit
+func (p Plan) duration(blocks int) string {
+	if !p.BuildUnit.IsZero() {
+		return p.BuildUnit.Amount(blocks)
+	}
+	if blocks != 1 {
+		return fmt.Sprintf("%d blocks", blocks)
+	}
+	return "1 block"
+}
@@ -0,0 +1,39 @@
+package exporter
+
+import (
+	"planner-microservice/planner"
+	"planner-microservice/units"
+	"testing"
+)
+
+// samplePlan returns a plan of two periods without a calendar, the title of
+// its task holds the characters every format escapes
+func samplePlan() Plan {
+	return Plan{
+		Table: [][]planner.TableCell{
+			{{Type: "routine", TodoId: "gym"}, {Type: "task", TodoId: "report"}, {Type: "task", TodoId: "report", Pinned: true}},
+			{{Type: "blocked"}, {Type: "free"}, {Type: "task", TodoId: "report"}},
+		},
+		Tasks:     []planner.Task{{Todo: planner.Todo{Id: "report", Title: `Report, "draft" | <b>notes</b>`, RequiredTime: 3}, Priority: 1, IsBreakable: true}},
+		Routines:  []planner.Routine{{Todo: planner.Todo{Id: "gym", Title: "Gym", RequiredTime: 1}}},
+		BuildUnit: units.Hour,
+	}
+}
+
+func TestRuns(t *testing.T) {
+	want := []run{
+		{period: 0, block: 0, length: 1, cell: planner.TableCell{Type: "routine", TodoId: "gym"}},
+		{period: 0, block: 1, length: 2, cell: planner.TableCell{Type: "task", TodoId: "report"}},
+		{period: 1, block: 2, length: 1, cell: planner.TableCell{Type: "task", TodoId: "report"}},
+	}
+	got := samplePlan().runs()
+	if len(got) != len(want) {
+		t.Fatalf("runs() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i].period != want[i].period || got[i].block != want[i].block || got[i].length != want[i].length ||
+			got[i].cell.Type != want[i].cell.Type || got[i].cell.TodoId != want[i].cell.TodoId {
+			t.Errorf("runs()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package exporter
+
+import (
+	"html/template"
+	"io"
+)
+
+// HTMLContentType is the media type of an HTML document
+const HTMLContentType = "text/html; charset=utf-8"
+
+// htmlCell is a cell of the grid, Span is the number of blocks it covers
+type htmlCell struct {
+	Type  string
+	Title string
+	Times string
+	Span  int
+}
+
+type htmlGrid struct {
+	Periods []string
+	Rows    [][]*htmlCell // a row per block, nil where a cell above spans over it
+}
+
+var htmlTemplate = template.Must(template.New("plan").Funcs(template.FuncMap{
+	"inc": func(i int) int { return i + 1 },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Plan</title>
+<style>
+  body { font-family: system-ui, sans-serif; margin: 2rem; color: #1f2328; }
+  table { border-collapse: collapse; width: 100%; table-layout: fixed; }
+  th, td { border: 1px solid #d0d7de; padding: 0.4rem; vertical-align: top; font-size: 0.85rem; }
+  th { background: #f6f8fa; }
+  td.task { background: #ddf4ff; }
+  td.routine { background: #fff8c5; }
+  td.blocked { background: repeating-linear-gradient(45deg, #f6f8fa, #f6f8fa 6px, #eaeef2 6px, #eaeef2 12px); color: #656d76; }
+  td.free, td.empty { color: #8c959f; }
+  .times { display: block; color: #656d76; font-size: 0.75rem; }
+  @media print {
+    body { margin: 0; }
+    th, td { -webkit-print-color-adjust: exact; print-color-adjust: exact; }
+  }
+</style>
+</head>
+<body>
+<h1>Plan</h1>
+<table>
+<thead>
+<tr><th scope="col">Block</th>{{range .Periods}}<th scope="col">{{.}}</th>{{end}}</tr>
+</thead>
+<tbody>
+{{range $i, $row := .Rows}}<tr><th scope="row">{{inc $i}}</th>{{range $row}}{{if .}}<td class="{{.Type}}"{{if gt .Span 1}} rowspan="{{.Span}}"{{end}}>{{.Title}}{{if .Times}}<span class="times">{{.Times}}</span>{{end}}</td>{{end}}{{end}}</tr>
+{{end}}</tbody>
+</table>
+</body>
+</html>
+`))
+
+// HTML renders a plan as a standalone printable grid with a column per
+// period and a row per block, consecutive blocks of the same todo are merged
+func HTML(w io.Writer, plan Plan) error {
+	rows := 0
+	for _, period := range plan.Table {
+		rows = max(rows, len(period))
+	}
+
+	grid := htmlGrid{
+		Periods: make([]string, len(plan.Table)),
+		Rows:    make([][]*htmlCell, rows),
+	}
+	for i := range plan.Table {
+		grid.Periods[i] = plan.periodLabel(i)
+	}
+
+	for j := range rows {
+		grid.Rows[j] = make([]*htmlCell, len(plan.Table))
+		for i, period := range plan.Table {
+			if j >= len(period) {
+				grid.Rows[j][i] = &htmlCell{Type: "empty", Span: 1}
+			}
+		}
+	}
+
+	for _, run := range plan.runs() {
+		grid.Rows[run.block][run.period] = &htmlCell{
+			Type:  run.cell.Type,
+			Title: plan.title(run.cell),
+			Times: plan.timeRange(run.period, run.block, run.length),
+			Span:  run.length,
+		}
+	}
+	for i, period := range plan.Table {
+		for j, cell := range period {
+			if cell.Type == "blocked" || cell.Type == "free" {
+				grid.Rows[j][i] = &htmlCell{
+					Type:  cell.Type,
+					Title: plan.title(cell),
+					Times: plan.timeRange(i, j, 1),
+					Span:  1,
+				}
+			}
+		}
+	}
+
+	return htmlTemplate.Execute(w, grid)
+}
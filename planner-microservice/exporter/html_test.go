@@ -0,0 +1,33 @@
+package exporter
+
+import (
+	"planner-microservice/planner"
+	"strings"
+	"testing"
+)
+
+func TestHTML(t *testing.T) {
+	plan := samplePlan()
+	plan.Table = append(plan.Table, []planner.TableCell{{Type: "task", TodoId: "report"}})
+
+	var out strings.Builder
+	if err := HTML(&out, plan); err != nil {
+		t.Fatal(err)
+	}
+	document := out.String()
+
+	for _, want := range []string{
+		`<tr><th scope="col">Block</th><th scope="col">Period 1</th><th scope="col">Period 2</th><th scope="col">Period 3</th></tr>`,
+		// the two blocks of the report in the first period are a single cell
+		`<tr><th scope="row">1</th><td class="routine">Gym</td><td class="blocked">Blocked</td><td class="task">Report, &#34;draft&#34; | &lt;b&gt;notes&lt;/b&gt;</td></tr>`,
+		`<tr><th scope="row">2</th><td class="task" rowspan="2">Report, &#34;draft&#34; | &lt;b&gt;notes&lt;/b&gt;</td><td class="free">Free</td><td class="empty"></td></tr>`,
+		`<tr><th scope="row">3</th><td class="task">Report, &#34;draft&#34; | &lt;b&gt;notes&lt;/b&gt;</td><td class="empty"></td></tr>`,
+	} {
+		if !strings.Contains(document, want) {
+			t.Errorf("document doesn't hold %q:\n%s", want, document)
+		}
+	}
+	if strings.Contains(document, "<b>notes") {
+		t.Error("the title isn't escaped")
+	}
+}
@@ -0,0 +1,92 @@
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ICSContentType is the media type of an iCalendar document
+const ICSContentType = "text/calendar; charset=utf-8"
+
+// icsTimeLayout is a UTC datetime as RFC 5545 writes it
+const icsTimeLayout = "20060102T150405Z"
+
+// ICS renders a plan as an RFC 5545 iCalendar document, consecutive blocks of
+// the same todo become a single event. stamp is the time the document is created.
+func ICS(w io.Writer, plan Plan, stamp time.Time) error {
+	if plan.Calendar == nil {
+		return errors.New("a plan needs a start datetime to be exported as a calendar")
+	}
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Fluiva//Planner//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+	}
+
+	for _, run := range plan.runs() {
+		todo, ok := plan.todo(run.cell)
+		if !ok {
+			return fmt.Errorf("unknown %s %q in period %d", run.cell.Type, run.cell.TodoId, run.period+1)
+		}
+
+		start := plan.Calendar.BlockStart(run.period, run.block)
+		end := plan.Calendar.BlockEnd(run.period, run.block+run.length-1)
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			fmt.Sprintf("UID:%s-%d-%d@fluiva", todo.Id, run.period, run.block),
+			"DTSTAMP:"+stamp.UTC().Format(icsTimeLayout),
+			"DTSTART:"+start.UTC().Format(icsTimeLayout),
+			"DTEND:"+end.UTC().Format(icsTimeLayout),
+			"SUMMARY:"+escapeText(todo.Title),
+		)
+		if todo.Description != "" {
+			lines = append(lines, "DESCRIPTION:"+escapeText(todo.Description))
+		}
+		lines = append(lines,
+			"CATEGORIES:"+strings.ToUpper(run.cell.Type),
+			"END:VEVENT",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := io.WriteString(w, foldLine(line)+"\r\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// escapeText escapes the characters RFC 5545 reserves in text values
+func escapeText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(value)
+}
+
+// foldLine splits a content line into lines of at most 75 octets,
+// continuation lines start with a space and no character is split
+func foldLine(line string) string {
+	var folded strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > 75 {
+			folded.WriteString("\r\n ")
+			width = 1
+		}
+		folded.WriteRune(r)
+		width += size
+	}
+	return folded.String()
+}
@@ -0,0 +1,123 @@
+package exporter
+
+import (
+	"planner-microservice/calendar"
+	"planner-microservice/planner"
+	"planner-microservice/units"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "Essay", want: "Essay"},
+		{value: "Read, write; repeat", want: `Read\, write\; repeat`},
+		{value: `C:\notes`, want: `C:\\notes`},
+		{value: "first\nsecond\r\nthird", want: `first\nsecond\nthird`},
+		{value: `\,`, want: `\\\,`},
+	}
+	for _, tt := range tests {
+		if got := escapeText(tt.value); got != tt.want {
+			t.Errorf("escapeText(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestFoldLine(t *testing.T) {
+	tests := []struct {
+		name  string
+		line  string
+		lines []string
+	}{
+		{name: "short", line: "SUMMARY:Essay", lines: []string{"SUMMARY:Essay"}},
+		{name: "75 octets", line: strings.Repeat("a", 75), lines: []string{strings.Repeat("a", 75)}},
+		{name: "76 octets", line: strings.Repeat("a", 76), lines: []string{strings.Repeat("a", 75), " a"}},
+		{
+			name:  "continuation lines",
+			line:  strings.Repeat("a", 75+74+3),
+			lines: []string{strings.Repeat("a", 75), " " + strings.Repeat("a", 74), " aaa"},
+		},
+		{
+			// é takes 2 octets and would end at octet 76
+			name:  "multibyte character",
+			line:  strings.Repeat("a", 74) + "é",
+			lines: []string{strings.Repeat("a", 74), " é"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := strings.Split(foldLine(tt.line), "\r\n")
+			if strings.Join(got, "|") != strings.Join(tt.lines, "|") {
+				t.Errorf("foldLine() = %q, want %q", got, tt.lines)
+			}
+		})
+	}
+}
+
+func TestICS(t *testing.T) {
+	start, err := calendar.ParseStart("2026-03-28T09:00", "Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cal, err := calendar.New(start, units.Day, units.Hour, nil, calendar.NewWeek(start.Weekday(), nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	title := "Write the report on planning, scheduling; and the C:\\ drive, then proofread it twice"
+	task, _ := planner.NewTask("report", title, "line one\nline two", 2, 2, true)
+	routine, _ := planner.NewRoutine("gym", "Gym", "", 1)
+	plan := Plan{
+		Table: [][]planner.TableCell{
+			{{Type: "routine", TodoId: "gym"}, {Type: "task", TodoId: "report"}, {Type: "task", TodoId: "report"}},
+			{{Type: "routine", TodoId: "gym"}, {Type: "free"}, {Type: "task", TodoId: "report"}},
+		},
+		Tasks:     []planner.Task{*task},
+		Routines:  []planner.Routine{*routine},
+		BuildUnit: units.Hour,
+		Calendar:  cal,
+	}
+
+	var out strings.Builder
+	if err := ICS(&out, plan, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	document := out.String()
+	if !strings.HasSuffix(document, "END:VCALENDAR\r\n") {
+		t.Error("document doesn't end with END:VCALENDAR and CRLF")
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(document, "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line of %d octets: %q", len(line), line)
+		}
+	}
+
+	unfolded := strings.ReplaceAll(document, "\r\n ", "")
+	for _, want := range []string{
+		"SUMMARY:" + escapeText(title) + "\r\n",
+		"DESCRIPTION:line one\\nline two\r\n",
+		// consecutive blocks are one event, times are in UTC over the start of DST
+		"UID:report-0-1@fluiva\r\nDTSTAMP:20260301T120000Z\r\nDTSTART:20260328T090000Z\r\nDTEND:20260328T110000Z\r\n",
+		"UID:report-1-2@fluiva\r\nDTSTAMP:20260301T120000Z\r\nDTSTART:20260329T090000Z\r\nDTEND:20260329T100000Z\r\n",
+		"UID:gym-1-0@fluiva\r\n",
+		"CATEGORIES:ROUTINE\r\n",
+	} {
+		if !strings.Contains(unfolded, want) {
+			t.Errorf("document doesn't hold %q:\n%s", want, unfolded)
+		}
+	}
+	if count := strings.Count(document, "BEGIN:VEVENT"); count != 4 {
+		t.Errorf("%d events, want 4", count)
+	}
+}
+
+func TestICSWithoutCalendar(t *testing.T) {
+	var out strings.Builder
+	if err := ICS(&out, Plan{}, time.Now()); err == nil {
+		t.Error("ICS() of a plan without a calendar succeeded, want an error")
+	}
+}
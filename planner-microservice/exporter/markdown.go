@@ -0,0 +1,59 @@
+package exporter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MarkdownContentType is the media type of a Markdown document
+const MarkdownContentType = "text/markdown; charset=utf-8"
+
+// Markdown renders a plan as a checklist per period,
+// consecutive blocks of the same todo are a single item
+func Markdown(w io.Writer, plan Plan) error {
+	var document strings.Builder
+	document.WriteString("# Plan\n")
+
+	runs := plan.runs()
+	for i := range plan.Table {
+		fmt.Fprintf(&document, "\n## %s\n\n", plan.periodLabel(i))
+
+		items := 0
+		for _, run := range runs {
+			if run.period != i {
+				continue
+			}
+
+			item := escapeMarkdown(plan.title(run.cell))
+			if times := plan.timeRange(run.period, run.block, run.length); times != "" {
+				item = times + " " + item
+			}
+			fmt.Fprintf(&document, "- [ ] %s (%s)\n", item, plan.duration(run.length))
+			items++
+		}
+
+		if items == 0 {
+			document.WriteString("Nothing planned.\n")
+		}
+	}
+
+	_, err := io.WriteString(w, document.String())
+	return err
+}
+
+// escapeMarkdown keeps titles from being read as Markdown syntax
+func escapeMarkdown(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		"*", `\*`,
+		"_", `\_`,
+		"`", "\\`",
+		"[", `\[`,
+		"]", `\]`,
+		"#", `\#`,
+		"<", `\<`,
+		"|", `\|`,
+		"\n", " ",
+	).Replace(value)
+}
@@ -0,0 +1,57 @@
+package exporter
+
+import (
+	"planner-microservice/planner"
+	"strings"
+	"testing"
+)
+
+func TestEscapeMarkdown(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "Essay", want: "Essay"},
+		{value: "Read, then write", want: "Read, then write"},
+		{value: "a | b", want: `a \| b`},
+		{value: "<b>bold</b>", want: `\<b>bold\</b>`},
+		{value: "*not* _emphasis_", want: `\*not\* \_emphasis\_`},
+		{value: "[link](url) #1 `code`", want: "\\[link\\](url) \\#1 \\`code\\`"},
+		{value: `C:\notes`, want: `C:\\notes`},
+		{value: "first\nsecond", want: "first second"},
+	}
+	for _, tt := range tests {
+		if got := escapeMarkdown(tt.value); got != tt.want {
+			t.Errorf("escapeMarkdown(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	plan := samplePlan()
+	plan.Table = append(plan.Table, []planner.TableCell{{Type: "free"}})
+
+	var out strings.Builder
+	if err := Markdown(&out, plan); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `# Plan
+
+## Period 1
+
+- [ ] Gym (1 hour)
+- [ ] Report, "draft" \| \<b>notes\</b> (2 hours)
+
+## Period 2
+
+- [ ] Report, "draft" \| \<b>notes\</b> (1 hour)
+
+## Period 3
+
+Nothing planned.
+`
+	if got := out.String(); got != want {
+		t.Errorf("Markdown() =\n%s\nwant\n%s", got, want)
+	}
+}
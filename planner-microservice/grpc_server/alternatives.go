@@ -0,0 +1,265 @@
+package grpc_server
+
+import (
+	"context"
+	"fmt"
+	"planner-microservice/planner"
+	pb "planner-microservice/proto"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultAlternatives is the number of plans returned when the request doesn't say
+	defaultAlternatives = 3
+	// maxAlternatives bounds the plans generated for a request
+	maxAlternatives = 10
+	// defaultAlternativesDeadline is the time the plans are generated in when the request doesn't say
+	defaultAlternativesDeadline = 2 * time.Second
+)
+
+// alternative is a way to generate a plan: a strategy, and a seed when the
+// table is improved by local search
+type alternative struct {
+	strategy string
+	improved bool
+	seed     int64
+}
+
+// alternativeResult is a plan generated for the alternative at index
+type alternativeResult struct {
+	index int
+	res   *pb.PlanResponse
+	err   error
+}
+
+func (s *PlannerServer) GeneratePlanAlternatives(ctx context.Context, req *pb.PlanAlternativesRequest) (*pb.PlanAlternativesResponse, error) {
+	if err := validatePlanAlternativesRequest(req); err != nil {
+		return nil, err
+	}
+
+	count := int(req.Count)
+	if count == 0 {
+		count = defaultAlternatives
+	}
+	wait := time.Duration(req.DeadlineMs) * time.Millisecond
+	if wait == 0 {
+		wait = defaultAlternativesDeadline
+	}
+	ctx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+
+	// every alternative is generated at once, those still running at the
+	// deadline are left out, the channel holds them all so none blocks
+	alternatives := planAlternatives(req.Plan.Seed, count)
+	results := make(chan alternativeResult, len(alternatives))
+	for i, alt := range alternatives {
+		go func() {
+			res, err := generateAlternative(ctx, req.Plan, alt)
+			results <- alternativeResult{index: i, res: res, err: err}
+		}()
+	}
+
+	generated := make([]alternativeResult, 0, len(alternatives))
+collect:
+	for range alternatives {
+		select {
+		case result := <-results:
+			generated = append(generated, result)
+		case <-ctx.Done():
+			break collect
+		}
+	}
+
+	// plans keep the order of the alternatives, whichever finished first
+	sort.Slice(generated, func(i, j int) bool {
+		return generated[i].index < generated[j].index
+	})
+
+	var plans []*pb.PlanAlternative
+	var firstErr error
+	seen := make(map[string]bool)
+	for _, result := range generated {
+		if result.err != nil {
+			if firstErr == nil {
+				firstErr = result.err
+			}
+			continue
+		}
+
+		key := tableKey(result.res.Periods)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		alt := alternatives[result.index]
+		plans = append(plans, &pb.PlanAlternative{
+			Plan:     result.res,
+			Strategy: alt.strategy,
+			Seed:     alt.seed,
+			Improved: alt.improved,
+			Score:    result.res.Metrics.GetScore(),
+		})
+	}
+
+	if len(plans) == 0 {
+		if firstErr != nil {
+			return nil, firstErr
+		}
+		return nil, status.Error(codes.DeadlineExceeded, "no plan was generated before the deadline")
+	}
+
+	// best score first, the first alternative first on a tie
+	sort.SliceStable(plans, func(i, j int) bool {
+		return plans[i].Score > plans[j].Score
+	})
+	plans = plans[:min(count, len(plans))]
+	describeAlternatives(plans)
+
+	return &pb.PlanAlternativesResponse{
+		Alternatives: plans,
+	}, nil
+}
+
+// planAlternatives lists a plan per strategy, then tables of the greedy and
+// balanced strategies improved with as many seeds as plans are wanted
+func planAlternatives(seed int64, count int) []alternative {
+	var alternatives []alternative
+	for _, strategy := range planner.Strategies() {
+		alternatives = append(alternatives, alternative{strategy: strategy.Name()})
+	}
+	for i := range int64(count) {
+		for _, strategy := range []string{"greedy", "balanced"} {
+			alternatives = append(alternatives, alternative{strategy: strategy, improved: true, seed: seed + i})
+		}
+	}
+	return alternatives
+}
+
+// generateAlternative generates the plan of a validated request with the
+// strategy and improvement of an alternative, searching for part of the time
+// left before the deadline of ctx and stopping at it
+func generateAlternative(ctx context.Context, req *pb.PlanRequest, alt alternative) (*pb.PlanResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	wait := defaultAlternativesDeadline
+	deadline, ok := ctx.Deadline()
+	if ok {
+		wait = time.Until(deadline)
+	}
+
+	req = proto.Clone(req).(*pb.PlanRequest)
+	req.Strategy = alt.strategy
+	req.Seed = alt.seed
+
+	budget := int32(wait.Milliseconds() * 3 / 4)
+	if req.TimeBudgetMs == 0 || req.TimeBudgetMs > budget {
+		req.TimeBudgetMs = max(budget, 1)
+	}
+	if alt.improved {
+		if req.Improvement == nil {
+			req.Improvement = &pb.Improvement{}
+		}
+		if req.Improvement.TimeLimitMs == 0 || req.Improvement.TimeLimitMs > budget {
+			req.Improvement.TimeLimitMs = max(budget, 1)
+		}
+	} else {
+		req.Improvement = nil
+	}
+
+	planner, err := plannerFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		planner.SetDeadline(deadline)
+	}
+	calendar, err := calendarFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	return generatePlan(planner, calendar)
+}
+
+// tableKey identifies a table to leave out alternatives that are the same plan
+func tableKey(periods []*pb.Period) string {
+	var key strings.Builder
+	for _, period := range periods {
+		for _, cell := range period.Cells {
+			fmt.Fprintf(&key, "%s:%s,", cell.Type, cell.TodoId)
+		}
+		key.WriteString(";")
+	}
+	return key.String()
+}
+
+// describeAlternatives tells how every plan differs from the others by the
+// metrics it's the best at, or by how it was generated
+func describeAlternatives(plans []*pb.PlanAlternative) {
+	type trait struct {
+		name  string
+		value func(*pb.PlanMetrics) float64 // lower is better
+	}
+	traits := []trait{
+		{"front-loaded", func(m *pb.PlanMetrics) float64 { return m.HighPriorityFinish }},
+		{"balanced", func(m *pb.PlanMetrics) float64 { return m.LoadVariance }},
+		{"fewest fragments", func(m *pb.PlanMetrics) float64 { return float64(m.Fragments) }},
+		{"fewest context switches", func(m *pb.PlanMetrics) float64 {
+			switches := 0
+			for _, count := range m.ContextSwitches {
+				switches += int(count)
+			}
+			return float64(switches)
+		}},
+	}
+
+	descriptions := make([][]string, len(plans))
+	for _, t := range traits {
+		values := make([]float64, len(plans))
+		for i, plan := range plans {
+			values[i] = t.value(plan.Plan.Metrics)
+		}
+
+		// a trait every plan shares doesn't tell them apart
+		best := slices.Min(values)
+		if best == slices.Max(values) {
+			continue
+		}
+		for i, value := range values {
+			if value == best {
+				descriptions[i] = append(descriptions[i], t.name)
+			}
+		}
+	}
+
+	// plans no trait tells apart, or that share their traits with a plan
+	// before them, are told apart by how they were generated
+	taken := make(map[string]bool)
+	for i, plan := range plans {
+		description := strings.Join(descriptions[i], ", ")
+		if description == "" {
+			description = generatedBy(plan)
+		} else if taken[description] {
+			description += " (" + generatedBy(plan) + ")"
+		}
+		taken[description] = true
+		plan.Description = description
+	}
+}
+
+// generatedBy describes how a plan was generated, no two alternatives share it
+func generatedBy(plan *pb.PlanAlternative) string {
+	description := plan.Strategy + " strategy"
+	if plan.Improved {
+		description += fmt.Sprintf(", improved with seed %d", plan.Seed)
+	}
+	return description
+}
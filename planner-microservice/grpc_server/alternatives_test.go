@@ -0,0 +1,59 @@
+package grpc_server
+
+import (
+	"context"
+	pb "planner-microservice/proto"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDescribeAlternatives(t *testing.T) {
+	metrics := func(variance float64, fragments int32) *pb.PlanResponse {
+		return &pb.PlanResponse{Metrics: &pb.PlanMetrics{LoadVariance: variance, Fragments: fragments}}
+	}
+	plans := []*pb.PlanAlternative{
+		{Plan: metrics(0.1, 4), Strategy: "balanced"},
+		{Plan: metrics(0.1, 4), Strategy: "greedy", Improved: true, Seed: 1},
+		{Plan: metrics(0.3, 2), Strategy: "greedy"},
+		{Plan: metrics(0.3, 3), Strategy: "solver"},
+		{Plan: metrics(0.3, 3), Strategy: "front-loaded"},
+	}
+	describeAlternatives(plans)
+
+	want := []string{
+		"balanced",
+		"balanced (greedy strategy, improved with seed 1)",
+		"fewest fragments",
+		"solver strategy",
+		"front-loaded strategy",
+	}
+	seen := make(map[string]bool)
+	for i, plan := range plans {
+		if plan.Description != want[i] {
+			t.Errorf("plan %d is described %q, want %q", i, plan.Description, want[i])
+		}
+		if seen[plan.Description] {
+			t.Errorf("two plans are described %q", plan.Description)
+		}
+		seen[plan.Description] = true
+	}
+}
+
+func TestGenerateAlternativeCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req := &pb.PlanRequest{
+		BuildUnit:  "hour",
+		PeriodUnit: "day",
+		NPeriods:   2,
+		NBlocks:    4,
+		Tasks:      []*pb.Task{{Todo: &pb.Todo{Id: "a", Title: "a", RequiredTime: 2, Type: "task"}, Priority: 1}},
+	}
+	_, err := generateAlternative(ctx, req, alternative{strategy: "solver"})
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("generateAlternative() error = %v, want %s", err, codes.Canceled)
+	}
+}
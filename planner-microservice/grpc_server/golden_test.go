@@ -0,0 +1,169 @@
+package grpc_server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	pb "planner-microservice/proto"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// The golden corpus keeps stored plans reproducible. A case is a directory
+// holding request.json, a PlanRequest, and either response.json, the
+// PlanResponse, or error.txt, the status of a failed request.
+//
+//	go test ./grpc_server -run TestGolden            check every case
+//	go test ./grpc_server -run TestGolden -update    store the current responses
+var update = flag.Bool("update", false, "store the current golden responses instead of checking them")
+
+const goldenDir = "../testdata/golden"
+
+func TestGolden(t *testing.T) {
+	cases, err := filepath.Glob(filepath.Join(goldenDir, "*", "request.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cases) == 0 {
+		t.Fatalf("no golden cases in %s", goldenDir)
+	}
+
+	for _, path := range cases {
+		caseDir := filepath.Dir(path)
+		t.Run(filepath.Base(caseDir), func(t *testing.T) {
+			checkGolden(t, caseDir)
+		})
+	}
+}
+
+// checkGolden generates the plan of a case twice, the two have to be the same
+// bytes and match the stored response or error
+func checkGolden(t *testing.T, caseDir string) {
+	content, err := os.ReadFile(filepath.Join(caseDir, "request.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &pb.PlanRequest{}
+	if err := protojson.Unmarshal(content, req); err != nil {
+		t.Fatalf("request.json: %v", err)
+	}
+
+	first, err := generateGolden(req)
+	second, secondErr := generateGolden(req)
+	if !bytes.Equal(first, second) || fmt.Sprint(err) != fmt.Sprint(secondErr) {
+		t.Fatal("the same request gave two different plans")
+	}
+
+	responsePath := filepath.Join(caseDir, "response.json")
+	errorPath := filepath.Join(caseDir, "error.txt")
+	if *update {
+		os.Remove(responsePath)
+		os.Remove(errorPath)
+		if err != nil {
+			err = os.WriteFile(errorPath, []byte(errorText(err)+"\n"), 0o644)
+		} else {
+			err = writeResponse(responsePath, first)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	if err != nil {
+		want, readErr := os.ReadFile(errorPath)
+		if readErr != nil {
+			t.Fatalf("unexpected error %q", errorText(err))
+		}
+		if got := errorText(err); got != strings.TrimSpace(string(want)) {
+			t.Fatalf("error %q, want %q", got, strings.TrimSpace(string(want)))
+		}
+		return
+	}
+
+	content, err = os.ReadFile(responsePath)
+	if err != nil {
+		t.Fatalf("unexpected response, %v", err)
+	}
+	want := &pb.PlanResponse{}
+	if err := protojson.Unmarshal(content, want); err != nil {
+		t.Fatalf("response.json: %v", err)
+	}
+	got := &pb.PlanResponse{}
+	if err := proto.Unmarshal(first, got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, want) {
+		t.Fatalf("response differs from response.json, first difference:\n%s", firstDifference(want, got))
+	}
+}
+
+// generateGolden returns the deterministic wire encoding of the response to a request
+func generateGolden(req *pb.PlanRequest) ([]byte, error) {
+	res, err := NewPlannerServer().GeneratePlan(context.Background(), proto.Clone(req).(*pb.PlanRequest))
+	if err != nil {
+		return nil, err
+	}
+	return proto.MarshalOptions{Deterministic: true}.Marshal(res)
+}
+
+func errorText(err error) string {
+	st := status.Convert(err)
+	return fmt.Sprintf("%s: %s", st.Code(), st.Message())
+}
+
+// writeResponse stores a response as indented JSON, reindented so the file
+// doesn't change with protojson's unstable whitespace
+func writeResponse(path string, encoded []byte) error {
+	lines, err := responseLines(encoded)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o644)
+}
+
+func responseLines(encoded []byte) ([]string, error) {
+	res := &pb.PlanResponse{}
+	if err := proto.Unmarshal(encoded, res); err != nil {
+		return nil, err
+	}
+	content, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, content, "", "  "); err != nil {
+		return nil, err
+	}
+	return strings.Split(indented.String(), "\n"), nil
+}
+
+// firstDifference returns the first line where two responses differ
+func firstDifference(want *pb.PlanResponse, got *pb.PlanResponse) string {
+	wantEncoded, _ := proto.MarshalOptions{Deterministic: true}.Marshal(want)
+	gotEncoded, _ := proto.MarshalOptions{Deterministic: true}.Marshal(got)
+	wantLines, _ := responseLines(wantEncoded)
+	gotLines, _ := responseLines(gotEncoded)
+
+	for i := range max(len(wantLines), len(gotLines)) {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return fmt.Sprintf("line %d\n  want: %s\n  got:  %s", i+1, strings.TrimSpace(w), strings.TrimSpace(g))
+		}
+	}
+	return "(none)"
+}
@@ -1,10 +1,18 @@
 package grpc_server
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"planner-microservice/calendar"
+	"planner-microservice/exporter"
+	"planner-microservice/importer"
 	"planner-microservice/planner"
 	pb "planner-microservice/proto"
+	"planner-microservice/units"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -19,50 +27,494 @@ func NewPlannerServer() *PlannerServer {
 }
 
 func (s *PlannerServer) GeneratePlan(ctx context.Context, req *pb.PlanRequest) (*pb.PlanResponse, error) {
+	if err := validatePlanRequest(req); err != nil {
+		return nil, err
+	}
 
-	// ... (rest of your existing GeneratePlan logic)
-	// Convert proto tasks to planner tasks
-	tasks := make([]planner.Task, len(req.Tasks))
+	planner, err := plannerFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
 
-	for i, protoTask := range req.Tasks {
-		tasks[i] = *planner.NewTask(
-			protoTask.Todo.Id,
-			protoTask.Todo.Title,
-			protoTask.Todo.Description,
-			int(protoTask.Todo.RequiredTime),
-			int(protoTask.Priority),
-			protoTask.IsBreakable,
-		)
+	calendar, err := calendarFromRequest(req)
+	if err != nil {
+		return nil, err
 	}
 
-	// Convert proto routines to planner routines
-	routines := make([]planner.Routine, len(req.Routines))
-	for i, protoRoutine := range req.Routines {
-		routines[i] = *planner.NewRoutine(
-			protoRoutine.Todo.Id,
-			protoRoutine.Todo.Title,
-			protoRoutine.Todo.Description,
-			int(protoRoutine.Todo.RequiredTime),
-		)
+	return generatePlan(planner, calendar)
+}
+
+func (s *PlannerServer) ReplanPlan(ctx context.Context, req *pb.ReplanRequest) (*pb.PlanResponse, error) {
+	if err := validateReplanRequest(req); err != nil {
+		return nil, err
+	}
+
+	// Convert pins to the cells they point at in the previous table
+	pinnedCells := make(map[planner.Slot]planner.TableCell, len(req.Pins))
+	pinFields := make(map[planner.Slot]string, len(req.Pins))
+	for i, pin := range req.Pins {
+		cell := req.Periods[pin.Period].Cells[pin.Block]
+		pinnedCell, err := planner.NewTableCell(cell.Type, cell.TodoId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		slot := planner.Slot{Period: int(pin.Period), Block: int(pin.Block)}
+		pinnedCells[slot] = *pinnedCell
+		pinFields[slot] = fmt.Sprintf("pins[%d]", i)
+	}
+
+	planner, err := plannerFromRequest(req.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := planner.SetPinnedCells(pinnedCells); err != nil {
+		return nil, pinsError(err, pinFields)
+	}
+
+	calendar, err := calendarFromRequest(req.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	return generatePlan(planner, calendar)
+}
+
+func (s *PlannerServer) ExportCalendar(ctx context.Context, req *pb.ExportCalendarRequest) (*pb.ExportResponse, error) {
+	if err := validateExportCalendarRequest(req); err != nil {
+		return nil, err
+	}
+
+	plan, err := exportPlanFromRequest(req.Plan, req.Periods)
+	if err != nil {
+		return nil, err
+	}
+
+	return exportDocument(plan, pb.ExportFormat_EXPORT_FORMAT_ICS)
+}
+
+func (s *PlannerServer) ExportPlan(ctx context.Context, req *pb.ExportPlanRequest) (*pb.ExportResponse, error) {
+	if err := validateExportPlanRequest(req); err != nil {
+		return nil, err
+	}
+
+	plan, err := exportPlanFromRequest(req.Plan, req.Periods)
+	if err != nil {
+		return nil, err
+	}
+
+	return exportDocument(plan, req.Format)
+}
+
+func (s *PlannerServer) ImportTodos(ctx context.Context, req *pb.ImportTodosRequest) (*pb.ImportTodosResponse, error) {
+	if err := validateImportTodosRequest(req); err != nil {
+		return nil, err
+	}
+
+	block, err := unitFromRequest(req.BuildUnit, req.BlockLength)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var result importer.Result
+	switch req.Format {
+	case pb.ImportFormat_IMPORT_FORMAT_CSV:
+		result = importer.CSV(req.Content, block)
+	case pb.ImportFormat_IMPORT_FORMAT_MARKDOWN:
+		result = importer.Markdown(req.Content, block)
+	case pb.ImportFormat_IMPORT_FORMAT_TODOIST:
+		result = importer.Todoist(req.Content, block)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported import format %s", req.Format)
+	}
+
+	importErrors := make([]*pb.ImportError, len(result.Errors))
+	for i, err := range result.Errors {
+		importErrors[i] = &pb.ImportError{
+			Line:    int32(err.Line),
+			Message: err.Message,
+		}
+	}
+
+	return &pb.ImportTodosResponse{
+		Tasks:    tasksToProto(result.Tasks),
+		Routines: routinesToProto(result.Routines),
+		Errors:   importErrors,
+	}, nil
+}
+
+func (s *PlannerServer) ListStrategies(ctx context.Context, req *pb.ListStrategiesRequest) (*pb.ListStrategiesResponse, error) {
+	strategies := planner.Strategies()
+	res := &pb.ListStrategiesResponse{
+		Strategies: make([]*pb.Strategy, len(strategies)),
+	}
+	for i, strategy := range strategies {
+		res.Strategies[i] = &pb.Strategy{
+			Name:        strategy.Name(),
+			Description: strategy.Description(),
+		}
+	}
+	return res, nil
+}
+
+func (s *PlannerServer) GetTimeConstraints(ctx context.Context, req *pb.TimeConstraintsRequest) (*pb.TimeConstraintsResponse, error) {
+	if err := validateTimeConstraintsRequest(req); err != nil {
+		return nil, err
+	}
+
+	// Convert proto todos to planner todos
+	tasks, err := tasksFromProto(req.Tasks)
+	if err != nil {
+		return nil, err
+	}
+	// Weekdays only tell which routines share a period, any first day will do
+	routines, err := routinesFromProto(req.Routines, calendar.NewWeek(time.Monday, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	block, period, err := timeConstraintsUnits(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// For leastBlocks and maxBlocks, use periods = 1 as default for leastBlocks, and the blocks of a period for maxBlocks
+	leastBlocks := planner.LeastBlocks(tasks, routines, 1)
+	maxBlocks := planner.MaxBlocks(tasks, routines, block, period)
+
+	// For leastPeriods and maxPeriods, use maxBlocks and leastBlocks as arguments
+	leastPeriods := planner.LeastPeriods(tasks, routines, maxBlocks)
+	maxPeriods := planner.MaxPeriods(tasks, routines, leastBlocks)
+
+	return &pb.TimeConstraintsResponse{
+		LeastBlocks:  int32(leastBlocks),
+		MaxBlocks:    int32(maxBlocks),
+		LeastPeriods: int32(leastPeriods),
+		MaxPeriods:   int32(maxPeriods),
+	}, nil
+}
+
+// timeUnits maps the time units of requests to the calendar units
+var timeUnits = map[pb.TimeUnit]units.Unit{
+	pb.TimeUnit_TIME_UNIT_MINUTE: units.Minute,
+	pb.TimeUnit_TIME_UNIT_HOUR:   units.Hour,
+	pb.TimeUnit_TIME_UNIT_DAY:    units.Day,
+	pb.TimeUnit_TIME_UNIT_WEEK:   units.Week,
+	pb.TimeUnit_TIME_UNIT_MONTH:  units.Month,
+}
+
+// unitFromRequest reads a unit given as a length or, by older clients, by name
+func unitFromRequest(name string, length *pb.TimeLength) (units.Unit, error) {
+	if length == nil {
+		return units.Parse(name)
+	}
+	if name != "" {
+		return units.Unit{}, fmt.Errorf("a unit can't be given both by name and as a length")
+	}
+
+	unit, ok := timeUnits[length.Unit]
+	if !ok {
+		return units.Unit{}, fmt.Errorf("%s is not a time unit", length.Unit)
+	}
+	if length.Count < 0 {
+		return units.Unit{}, fmt.Errorf("the count of a length must not be negative")
+	}
+	return unit.Times(max(int(length.Count), 1)), nil
+}
+
+// planUnits returns the units of the blocks and periods of a plan request
+func planUnits(req *pb.PlanRequest) (units.Unit, units.Unit, error) {
+	block, err := unitFromRequest(req.BuildUnit, req.BlockLength)
+	if err != nil {
+		return units.Unit{}, units.Unit{}, err
+	}
+	period, err := unitFromRequest(req.PeriodUnit, req.PeriodLength)
+	if err != nil {
+		return units.Unit{}, units.Unit{}, err
+	}
+	if !block.Divides(period) {
+		return units.Unit{}, units.Unit{}, fmt.Errorf("a block of %s doesn't divide a period of %s", block.Amount(1), period.Amount(1))
+	}
+	return block, period, nil
+}
+
+// isPlanOfDays reports whether the periods of a plan request are days,
+// the only periods skipped weekdays and block start times apply to
+func isPlanOfDays(req *pb.PlanRequest) bool {
+	period, err := unitFromRequest(req.PeriodUnit, req.PeriodLength)
+	return err == nil && period == units.Day
+}
+
+// timeConstraintsUnits returns the units of the blocks and periods of a time
+// constraints request, periods are the calendar unit after the block's when not given
+func timeConstraintsUnits(req *pb.TimeConstraintsRequest) (units.Unit, units.Unit, error) {
+	block, err := unitFromRequest(req.BlocksUnit, req.BlockLength)
+	if err != nil {
+		return units.Unit{}, units.Unit{}, err
+	}
+
+	if req.PeriodUnit == "" && req.PeriodLength == nil {
+		period, ok := block.Larger()
+		if !ok {
+			return units.Unit{}, units.Unit{}, fmt.Errorf("blocks of %s need a period unit", block.Amount(1))
+		}
+		return block, period, nil
+	}
+
+	period, err := unitFromRequest(req.PeriodUnit, req.PeriodLength)
+	if err != nil {
+		return units.Unit{}, units.Unit{}, err
+	}
+	if !block.Divides(period) {
+		return units.Unit{}, units.Unit{}, fmt.Errorf("a block of %s doesn't divide a period of %s", block.Amount(1), period.Amount(1))
+	}
+	return block, period, nil
+}
+
+// overflowPolicies maps the overflow policies of requests to the planner's
+var overflowPolicies = map[pb.OverflowPolicy]planner.Overflow{
+	pb.OverflowPolicy_OVERFLOW_POLICY_GROW:        planner.OverflowGrow,
+	pb.OverflowPolicy_OVERFLOW_POLICY_STRICT:      planner.OverflowStrict,
+	pb.OverflowPolicy_OVERFLOW_POLICY_BEST_EFFORT: planner.OverflowBestEffort,
+}
+
+// plannerFromRequest converts a validated plan request to a planner
+func plannerFromRequest(req *pb.PlanRequest) (*planner.Planner, error) {
+	// Convert proto todos to planner todos
+	tasks, err := tasksFromProto(req.Tasks)
+	if err != nil {
+		return nil, err
+	}
+	routines, err := routinesFromProto(req.Routines, weekFromRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	// A capacity per period can stand for n_periods
+	nPeriods := int(req.NPeriods)
+	if nPeriods == 0 {
+		nPeriods = len(req.PeriodCapacities)
+	}
+
+	blockedPeriods := make([]int, len(req.BlockedPeriods))
+	for i, period := range req.BlockedPeriods {
+		blockedPeriods[i] = int(period)
+	}
+
+	blockedSlots := make([]planner.Slot, len(req.BlockedSlots))
+	for i, slot := range req.BlockedSlots {
+		blockedSlots[i] = planner.Slot{Period: int(slot.Period), Block: int(slot.Block)}
+	}
+
+	block, period, err := planUnits(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// Create new planner
-	planner := planner.NewPlanner(
-		req.BuildUnit,
-		req.PeriodUnit,
+	p := planner.NewPlanner(
+		block,
+		period,
 		tasks,
 		routines,
-		int(req.NPeriods),
+		nPeriods,
 		int(req.NBlocks),
 	)
 
-	// Validate plan parameters
-	if !planner.ValidatePlanParameters() {
-		return nil, status.Error(codes.InvalidArgument, "invalid plan parameters")
+	if len(req.PeriodCapacities) > 0 {
+		capacities := make([]int, len(req.PeriodCapacities))
+		for i, capacity := range req.PeriodCapacities {
+			capacities[i] = int(capacity)
+		}
+		p.SetPeriodCapacities(capacities)
+	}
+
+	p.SetBlockedPeriods(blockedPeriods)
+	p.SetBlockedSlots(blockedSlots)
+
+	strategy, ok := planner.StrategyNamed(req.Strategy)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown strategy %q", req.Strategy)
+	}
+	p.SetStrategy(strategy)
+	p.SetTimeBudget(time.Duration(req.TimeBudgetMs) * time.Millisecond)
+
+	overflow, ok := overflowPolicies[req.Overflow]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown overflow policy %d", req.Overflow)
+	}
+	p.SetOverflow(overflow)
+
+	if req.Improvement != nil {
+		p.SetImprovement(&planner.Improvement{
+			Seed:       req.Seed,
+			Iterations: int(req.Improvement.Iterations),
+			TimeLimit:  time.Duration(req.Improvement.TimeLimitMs) * time.Millisecond,
+		})
+	}
+
+	return p, nil
+}
+
+// weekFromRequest places the periods of a plan of days on the days of the week
+func weekFromRequest(req *pb.PlanRequest) calendar.Week {
+	start := time.Monday
+	if weekday, err := calendar.ParseWeekday(req.StartWeekday); err == nil {
+		start = weekday
+	}
+	if datetime, err := calendar.ParseStart(req.StartDatetime, req.TimeZone); err == nil {
+		start = datetime.Weekday()
+	}
+
+	var skipped []time.Weekday
+	if isPlanOfDays(req) {
+		for _, name := range req.SkippedWeekdays {
+			if weekday, err := calendar.ParseWeekday(name); err == nil {
+				skipped = append(skipped, weekday)
+			}
+		}
+	}
+	return calendar.NewWeek(start, skipped)
+}
+
+// calendarFromRequest maps the periods of a validated plan request to
+// datetimes, nil when the plan has no start datetime
+func calendarFromRequest(req *pb.PlanRequest) (*calendar.Calendar, error) {
+	if req.StartDatetime == "" {
+		return nil, nil
+	}
+
+	start, err := calendar.ParseStart(req.StartDatetime, req.TimeZone)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	blockTimes := make([]calendar.Clock, len(req.BlockStartTimes))
+	for i, value := range req.BlockStartTimes {
+		if blockTimes[i], err = calendar.ParseClock(value); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	block, period, err := planUnits(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	c, err := calendar.New(start, period, block, blockTimes, weekFromRequest(req))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return c, nil
+}
+
+// exportPlanFromRequest collects what exporters need from a validated plan request,
+// the table is generated from the request when periods is empty
+func exportPlanFromRequest(req *pb.PlanRequest, periods []*pb.Period) (exporter.Plan, error) {
+	tasks, err := tasksFromProto(req.Tasks)
+	if err != nil {
+		return exporter.Plan{}, err
+	}
+	routines, err := routinesFromProto(req.Routines, weekFromRequest(req))
+	if err != nil {
+		return exporter.Plan{}, err
+	}
+
+	calendar, err := calendarFromRequest(req)
+	if err != nil {
+		return exporter.Plan{}, err
+	}
+	block, _, err := planUnits(req)
+	if err != nil {
+		return exporter.Plan{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(periods) == 0 {
+		planner, err := plannerFromRequest(req)
+		if err != nil {
+			return exporter.Plan{}, err
+		}
+
+		res, err := generatePlan(planner, nil)
+		if err != nil {
+			return exporter.Plan{}, err
+		}
+		periods = res.Periods
+	}
+
+	return exporter.Plan{
+		Table:     tableFromProto(periods),
+		Tasks:     tasks,
+		Routines:  routines,
+		BuildUnit: block,
+		Calendar:  calendar,
+	}, nil
+}
+
+// exportDocument renders a plan in an export format
+func exportDocument(plan exporter.Plan, format pb.ExportFormat) (*pb.ExportResponse, error) {
+	var content bytes.Buffer
+	var err error
+	res := &pb.ExportResponse{}
+	switch format {
+	case pb.ExportFormat_EXPORT_FORMAT_CSV:
+		err = exporter.CSV(&content, plan)
+		res.ContentType, res.FileName = exporter.CSVContentType, "plan.csv"
+	case pb.ExportFormat_EXPORT_FORMAT_MARKDOWN:
+		err = exporter.Markdown(&content, plan)
+		res.ContentType, res.FileName = exporter.MarkdownContentType, "plan.md"
+	case pb.ExportFormat_EXPORT_FORMAT_HTML:
+		err = exporter.HTML(&content, plan)
+		res.ContentType, res.FileName = exporter.HTMLContentType, "plan.html"
+	case pb.ExportFormat_EXPORT_FORMAT_ICS:
+		err = exporter.ICS(&content, plan, time.Now())
+		res.ContentType, res.FileName = exporter.ICSContentType, "plan.ics"
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported export format %s", format)
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res.Content = content.String()
+	return res, nil
+}
+
+// tableFromProto converts the periods of a plan response back to a table
+func tableFromProto(periods []*pb.Period) [][]planner.TableCell {
+	table := make([][]planner.TableCell, len(periods))
+	for i, period := range periods {
+		table[i] = make([]planner.TableCell, len(period.GetCells()))
+		for j, cell := range period.GetCells() {
+			table[i][j] = planner.TableCell{
+				Type:   cell.GetType(),
+				TodoId: cell.GetTodoId(),
+				Pinned: cell.GetPinned(),
+			}
+		}
+	}
+	return table
+}
+
+// generatePlan validates the planner's parameters and generates its table,
+// cells get their datetimes when a calendar is given
+func generatePlan(planner *planner.Planner, calendar *calendar.Calendar) (*pb.PlanResponse, error) {
+	// Reject unknown prerequisites and dependency cycles
+	if err := planner.ValidateDependencies(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Validate plan parameters, explaining what fails to the caller
+	if infeasibilities := planner.Diagnose(); len(infeasibilities) > 0 {
+		return nil, diagnosticsStatus(infeasibilities).Err()
 	}
 
 	// Generate table
-	table := planner.GenerateTable()
+	table, err := planner.GenerateTable()
+	if err != nil {
+		return nil, tableError(err)
+	}
 
 	// Convert table to response format
 	periods := make([]*pb.Period, len(table))
@@ -72,24 +524,134 @@ func (s *PlannerServer) GeneratePlan(ctx context.Context, req *pb.PlanRequest) (
 			cells[j] = &pb.TableCell{
 				Type:   cell.Type,
 				TodoId: cell.TodoId,
+				Pinned: cell.Pinned,
+			}
+			if calendar != nil {
+				cells[j].Start = calendar.BlockStart(i, j).Format(time.RFC3339)
+				cells[j].End = calendar.BlockEnd(i, j).Format(time.RFC3339)
 			}
 		}
 		periods[i] = &pb.Period{
 			Cells: cells,
 		}
+		if calendar != nil {
+			periods[i].Start = calendar.PeriodStart(i).Format(time.RFC3339)
+		}
 	}
 
 	return &pb.PlanResponse{
-		Periods:   periods,
-		TotalTime: planner.TotalTimeInPeriodUnit(),
+		Periods:         periods,
+		TotalTime:       planner.TotalTimeInPeriodUnit(),
+		ExceededPeriods: periodsToProto(planner.ExceededPeriods()),
+		Metrics:         metricsToProto(planner.Evaluate(table)),
+		Unscheduled:     unscheduledToProto(planner.Unscheduled()),
+		AppendedPeriods: periodsToProto(planner.AppendedPeriods()),
 	}, nil
 }
 
-func (s *PlannerServer) GetTimeConstraints(ctx context.Context, req *pb.TimeConstraintsRequest) (*pb.TimeConstraintsResponse, error) {
-	// Convert proto tasks to planner tasks
-	tasks := make([]planner.Task, len(req.Tasks))
-	for i, protoTask := range req.Tasks {
-		tasks[i] = *planner.NewTask(
+func periodsToProto(indexes []int) []int32 {
+	periods := make([]int32, len(indexes))
+	for i, index := range indexes {
+		periods[i] = int32(index)
+	}
+	return periods
+}
+
+func unscheduledToProto(unscheduled []planner.Unscheduled) []*pb.UnscheduledTodo {
+	todos := make([]*pb.UnscheduledTodo, len(unscheduled))
+	for i, todo := range unscheduled {
+		todos[i] = &pb.UnscheduledTodo{
+			Type:          todo.Type,
+			TodoId:        todo.TodoId,
+			MissingBlocks: int32(todo.Missing),
+			Reason:        todo.Reason,
+			Periods:       periodsToProto(todo.Periods),
+		}
+	}
+	return todos
+}
+
+// pinsError turns an error of SetPinnedCells into a status, the cells that
+// can't be pinned are violations of the pins pointing at them
+func pinsError(err error, fields map[planner.Slot]string) error {
+	var pinErr *planner.PinError
+	if !errors.As(err, &pinErr) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var violations fieldViolations
+	for _, pin := range pinErr.Pins {
+		violations.add(fields[pin.Slot], "%s", pin.Description)
+	}
+	return violations.err()
+}
+
+// tableError turns an error of GenerateTable into a status, strict plans
+// that don't fit carry what's left out as details
+func tableError(err error) error {
+	var capacityErr *planner.CapacityError
+	if errors.As(err, &capacityErr) {
+		return diagnosticsStatus(capacityErr.Infeasibilities).Err()
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+func metricsToProto(metrics planner.Metrics) *pb.PlanMetrics {
+	contextSwitches := make([]int32, len(metrics.ContextSwitches))
+	for i, count := range metrics.ContextSwitches {
+		contextSwitches[i] = int32(count)
+	}
+
+	return &pb.PlanMetrics{
+		Utilisation:        metrics.Utilisation,
+		LoadVariance:       metrics.LoadVariance,
+		Fragments:          int32(metrics.Fragments),
+		ContextSwitches:    contextSwitches,
+		HighPriorityPeriod: int32(metrics.HighPriorityPeriod),
+		HighPriorityFinish: metrics.HighPriorityFinish,
+		UnusedCapacity:     int32(metrics.UnusedCapacity),
+		Score:              metrics.Score,
+	}
+}
+
+// diagnosticsStatus builds an InvalidArgument status carrying the failed constraints as details
+func diagnosticsStatus(infeasibilities []planner.Infeasibility) *status.Status {
+	descriptions := make([]string, len(infeasibilities))
+	diagnostics := &pb.PlanDiagnostics{}
+	for i, infeasibility := range infeasibilities {
+		descriptions[i] = infeasibility.Description
+
+		fixes := make([]*pb.SuggestedFix, len(infeasibility.Fixes))
+		for j, fix := range infeasibility.Fixes {
+			fixes[j] = &pb.SuggestedFix{
+				Field:       fix.Field,
+				Value:       int32(fix.Value),
+				Description: fix.Description,
+			}
+		}
+
+		diagnostics.Infeasibilities = append(diagnostics.Infeasibilities, &pb.Infeasibility{
+			Constraint:  infeasibility.Constraint,
+			Description: infeasibility.Description,
+			TodoIds:     infeasibility.TodoIds,
+			FirstPeriod: int32(infeasibility.FirstPeriod),
+			LastPeriod:  int32(infeasibility.LastPeriod),
+			Shortfall:   int32(infeasibility.Shortfall),
+			Fixes:       fixes,
+		})
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid plan parameters: "+strings.Join(descriptions, "; "))
+	if detailed, err := st.WithDetails(diagnostics); err == nil {
+		return detailed
+	}
+	return st
+}
+
+func tasksFromProto(protoTasks []*pb.Task) ([]planner.Task, error) {
+	tasks := make([]planner.Task, len(protoTasks))
+	for i, protoTask := range protoTasks {
+		task, err := planner.NewTask(
 			protoTask.Todo.Id,
 			protoTask.Todo.Title,
 			protoTask.Todo.Description,
@@ -97,34 +659,107 @@ func (s *PlannerServer) GetTimeConstraints(ctx context.Context, req *pb.TimeCons
 			int(protoTask.Priority),
 			protoTask.IsBreakable,
 		)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "tasks[%d]: %v", i, err)
+		}
+
+		tasks[i] = *task
+		tasks[i].Prerequisites = protoTask.Prerequisites
+		tasks[i].MinChunk = int(protoTask.MinChunk)
+		tasks[i].MaxChunk = int(protoTask.MaxChunk)
+		if protoTask.Deadline != nil {
+			deadline := int(*protoTask.Deadline)
+			tasks[i].Deadline = &deadline
+		}
+		if protoTask.EarliestStart != nil {
+			earliestStart := int(*protoTask.EarliestStart)
+			tasks[i].EarliestStart = &earliestStart
+		}
 	}
+	return tasks, nil
+}
 
-	// Convert proto routines to planner routines
-	routines := make([]planner.Routine, len(req.Routines))
-	for i, protoRoutine := range req.Routines {
-		routines[i] = *planner.NewRoutine(
+// routinesFromProto converts proto routines, weekdays become a recurrence
+// over the working days of the week starting at the first period
+func routinesFromProto(protoRoutines []*pb.Routine, week calendar.Week) ([]planner.Routine, error) {
+	routines := make([]planner.Routine, len(protoRoutines))
+	for i, protoRoutine := range protoRoutines {
+		routine, err := planner.NewRoutine(
 			protoRoutine.Todo.Id,
 			protoRoutine.Todo.Title,
 			protoRoutine.Todo.Description,
 			int(protoRoutine.Todo.RequiredTime),
 		)
-	}
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "routines[%d]: %v", i, err)
+		}
+		routines[i] = *routine
+		routines[i].Position = protoRoutine.Position
+		routines[i].Block = int(protoRoutine.Block)
+		routines[i].Every = int(protoRoutine.Every)
+		routines[i].Times = int(protoRoutine.Times)
+		for _, offset := range protoRoutine.Offsets {
+			routines[i].Offsets = append(routines[i].Offsets, int(offset))
+		}
 
-	fmt.Println(tasks)
-	fmt.Println(routines)
+		if len(protoRoutine.Weekdays) > 0 {
+			days := make([]time.Weekday, len(protoRoutine.Weekdays))
+			for j, name := range protoRoutine.Weekdays {
+				days[j] = calendar.Weekdays[name]
+			}
+			routines[i].Every, routines[i].Offsets = week.Recurrence(days)
+		}
+	}
+	return routines, nil
+}
 
-	// For leastBlocks and maxBlocks, use periods = 1 as default for leastBlocks, and blocks_unit for maxBlocks
-	leastBlocks := planner.LeastBlocks(tasks, routines, 1)
-	maxBlocks := planner.MaxBlocks(tasks, routines, req.BlocksUnit)
+// todoToProto converts a planner todo to its proto message
+func todoToProto(todo planner.Todo) *pb.Todo {
+	return &pb.Todo{
+		Id:           todo.Id,
+		Title:        todo.Title,
+		Description:  todo.Description,
+		RequiredTime: int32(todo.RequiredTime),
+		Type:         todo.Type,
+	}
+}
 
-	// For leastPeriods and maxPeriods, use maxBlocks and leastBlocks as arguments
-	leastPeriods := planner.LeastPeriods(tasks, routines, maxBlocks)
-	maxPeriods := planner.MaxPeriods(tasks, routines, leastBlocks)
+func tasksToProto(tasks []planner.Task) []*pb.Task {
+	protoTasks := make([]*pb.Task, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = &pb.Task{
+			Todo:          todoToProto(task.Todo),
+			Priority:      int32(task.Priority),
+			IsBreakable:   task.IsBreakable,
+			Prerequisites: task.Prerequisites,
+			MinChunk:      int32(task.MinChunk),
+			MaxChunk:      int32(task.MaxChunk),
+		}
+		if task.Deadline != nil {
+			deadline := int32(*task.Deadline)
+			protoTasks[i].Deadline = &deadline
+		}
+		if task.EarliestStart != nil {
+			earliestStart := int32(*task.EarliestStart)
+			protoTasks[i].EarliestStart = &earliestStart
+		}
+	}
+	return protoTasks
+}
 
-	return &pb.TimeConstraintsResponse{
-		LeastBlocks:  int32(leastBlocks),
-		MaxBlocks:    int32(maxBlocks),
-		LeastPeriods: int32(leastPeriods),
-		MaxPeriods:   int32(maxPeriods),
-	}, nil
+func routinesToProto(routines []planner.Routine) []*pb.Routine {
+	protoRoutines := make([]*pb.Routine, len(routines))
+	for i, routine := range routines {
+		protoRoutines[i] = &pb.Routine{
+			Todo:     todoToProto(routine.Todo),
+			Position: routine.Position,
+			Block:    int32(routine.Block),
+			Every:    int32(routine.Every),
+			Times:    int32(routine.Times),
+		}
+		for _, offset := range routine.Offsets {
+			protoRoutines[i].Offsets = append(protoRoutines[i].Offsets, int32(offset))
+		}
+	}
+	return protoRoutines
 }
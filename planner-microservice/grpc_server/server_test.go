@@ -0,0 +1,94 @@
+package grpc_server
+
+import (
+	"context"
+	pb "planner-microservice/proto"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGeneratePlanRejectsDependencies(t *testing.T) {
+	_, err := NewPlannerServer().GeneratePlan(context.Background(), &pb.PlanRequest{
+		BuildUnit:  "hour",
+		PeriodUnit: "day",
+		NPeriods:   3,
+		NBlocks:    4,
+		Tasks: []*pb.Task{
+			{Todo: &pb.Todo{Id: "read", RequiredTime: 1}, Priority: 1, Prerequisites: []string{"problems"}},
+			{Todo: &pb.Todo{Id: "problems", RequiredTime: 1}, Priority: 1, Prerequisites: []string{"read", "lecture"}},
+		},
+	})
+
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("GeneratePlan() error = %v, want %s", err, codes.InvalidArgument)
+	}
+	for _, want := range []string{"unknown prerequisite ids: lecture", "dependency cycle between tasks: read, problems"} {
+		if !strings.Contains(st.Message(), want) {
+			t.Errorf("GeneratePlan() error = %q, want it to say %q", st.Message(), want)
+		}
+	}
+}
+
+func TestReplanPlan(t *testing.T) {
+	deadline := int32(0)
+	plan := &pb.PlanRequest{
+		BuildUnit:  "hour",
+		PeriodUnit: "day",
+		NPeriods:   2,
+		NBlocks:    4,
+		Tasks: []*pb.Task{
+			{Todo: &pb.Todo{Id: "essay", Title: "Essay", RequiredTime: 3}, Priority: 1},
+			{Todo: &pb.Todo{Id: "mail", Title: "Mail", RequiredTime: 2}, Priority: 2, IsBreakable: true, Deadline: &deadline},
+		},
+		Routines: []*pb.Routine{{Todo: &pb.Todo{Id: "walk", Title: "Walk", RequiredTime: 1}, Position: "start"}},
+	}
+	server := NewPlannerServer()
+	res, err := server.GeneratePlan(context.Background(), plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every cell of its own output can be kept
+	var pins []*pb.Slot
+	for i, period := range res.Periods {
+		for block, cell := range period.Cells {
+			if cell.Type == "task" || cell.Type == "routine" {
+				pins = append(pins, &pb.Slot{Period: int32(i), Block: int32(block)})
+			}
+		}
+	}
+	replanned, err := server.ReplanPlan(context.Background(), &pb.ReplanRequest{Plan: plan, Periods: res.Periods, Pins: pins})
+	if err != nil {
+		t.Fatalf("ReplanPlan() error = %v", err)
+	}
+	for _, pin := range pins {
+		before, after := res.Periods[pin.Period].Cells[pin.Block], replanned.Periods[pin.Period].Cells[pin.Block]
+		if after.TodoId != before.TodoId || !after.Pinned {
+			t.Errorf("slot (%d, %d) holds %q, want pinned %q", pin.Period, pin.Block, after.TodoId, before.TodoId)
+		}
+	}
+
+	// pins that break the tasks are reported together, on their own fields
+	cell := func(id string) *pb.TableCell {
+		return &pb.TableCell{Type: "task", TodoId: id}
+	}
+	free := &pb.TableCell{Type: "free"}
+	periods := []*pb.Period{
+		{Cells: []*pb.TableCell{free, cell("essay"), free, free}},
+		{Cells: []*pb.TableCell{cell("essay"), free, free, cell("mail")}},
+	}
+	_, err = server.ReplanPlan(context.Background(), &pb.ReplanRequest{
+		Plan:    plan,
+		Periods: periods,
+		Pins: []*pb.Slot{
+			{Period: 1, Block: 3},
+			{Period: 0, Block: 1},
+			{Period: 1, Block: 0},
+		},
+	})
+	checkViolations(t, err, []string{"pins[2]", "pins[0]"})
+}
@@ -0,0 +1,511 @@
+package grpc_server
+
+import (
+	"fmt"
+	"planner-microservice/calendar"
+	"planner-microservice/planner"
+	pb "planner-microservice/proto"
+	"planner-microservice/units"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fieldViolations collects every problem of a request,
+// so the caller can fix all of them at once
+type fieldViolations []*errdetails.BadRequest_FieldViolation
+
+func (v *fieldViolations) add(field string, format string, args ...any) {
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// err returns an InvalidArgument status carrying the violations as a
+// BadRequest detail, nil when there are none
+func (v fieldViolations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, len(v))
+	for i, violation := range v {
+		descriptions[i] = violation.Field + " " + violation.Description
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid request: "+strings.Join(descriptions, "; "))
+	if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v}); err == nil {
+		return detailed.Err()
+	}
+	return st.Err()
+}
+
+func validatePlanRequest(req *pb.PlanRequest) error {
+	var violations fieldViolations
+	violations.checkPlanRequest("", req)
+	return violations.err()
+}
+
+func validatePlanAlternativesRequest(req *pb.PlanAlternativesRequest) error {
+	var violations fieldViolations
+	if req.Plan == nil {
+		violations.add("plan", "is required")
+	} else {
+		violations.checkPlanRequest("plan.", req.Plan)
+	}
+	if req.Count < 0 || req.Count > maxAlternatives {
+		violations.add("count", "must be between 0 and %d", maxAlternatives)
+	}
+	if req.DeadlineMs < 0 {
+		violations.add("deadline_ms", "must not be negative")
+	}
+	return violations.err()
+}
+
+func validateReplanRequest(req *pb.ReplanRequest) error {
+	var violations fieldViolations
+	if req.Plan == nil {
+		violations.add("plan", "is required")
+	} else {
+		violations.checkPlanRequest("plan.", req.Plan)
+	}
+
+	for i, pin := range req.Pins {
+		field := fmt.Sprintf("pins[%d]", i)
+		if pin == nil {
+			violations.add(field, "is required")
+			continue
+		}
+		if pin.Period < 0 || int(pin.Period) >= len(req.Periods) ||
+			pin.Block < 0 || int(pin.Block) >= len(req.Periods[pin.Period].GetCells()) {
+			violations.add(field, "(%d, %d) is outside the previous plan", pin.Period, pin.Block)
+			continue
+		}
+
+		cell := req.Periods[pin.Period].Cells[pin.Block]
+		if cell.GetType() != "task" && cell.GetType() != "routine" {
+			violations.add(field, "(%d, %d) points at a %q cell", pin.Period, pin.Block, cell.GetType())
+		}
+	}
+
+	return violations.err()
+}
+
+func validateExportCalendarRequest(req *pb.ExportCalendarRequest) error {
+	var violations fieldViolations
+	violations.checkExport(req.Plan, req.Periods, pb.ExportFormat_EXPORT_FORMAT_ICS)
+	return violations.err()
+}
+
+func validateExportPlanRequest(req *pb.ExportPlanRequest) error {
+	var violations fieldViolations
+	if _, ok := pb.ExportFormat_name[int32(req.Format)]; !ok || req.Format == pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
+		violations.add("format", "must be csv, markdown, html or ics")
+	}
+	violations.checkExport(req.Plan, req.Periods, req.Format)
+	return violations.err()
+}
+
+// checkExport validates the plan and table of an export request
+func (v *fieldViolations) checkExport(plan *pb.PlanRequest, periods []*pb.Period, format pb.ExportFormat) {
+	if plan == nil {
+		v.add("plan", "is required")
+		return
+	}
+
+	v.checkPlanRequest("plan.", plan)
+	if format == pb.ExportFormat_EXPORT_FORMAT_ICS && plan.StartDatetime == "" {
+		v.add("plan.start_datetime", "is required to export a calendar")
+	}
+	v.checkPeriods("", plan, periods)
+}
+
+func validateImportTodosRequest(req *pb.ImportTodosRequest) error {
+	var violations fieldViolations
+	if strings.TrimSpace(req.Content) == "" {
+		violations.add("content", "is required")
+	}
+	if _, ok := pb.ImportFormat_name[int32(req.Format)]; !ok || req.Format == pb.ImportFormat_IMPORT_FORMAT_UNSPECIFIED {
+		violations.add("format", "must be csv, markdown or todoist")
+	}
+	violations.checkUnit("build_unit", req.BuildUnit, "block_length", req.BlockLength)
+	return violations.err()
+}
+
+func validateTimeConstraintsRequest(req *pb.TimeConstraintsRequest) error {
+	var violations fieldViolations
+	block := violations.checkUnit("blocks_unit", req.BlocksUnit, "block_length", req.BlockLength)
+	if req.PeriodUnit == "" && req.PeriodLength == nil {
+		if _, ok := block.Larger(); !ok && !block.IsZero() {
+			violations.add("period_unit", "is required for blocks of %s", block.Amount(1))
+		}
+	} else {
+		period := violations.checkUnit("period_unit", req.PeriodUnit, "period_length", req.PeriodLength)
+		violations.checkDivides("blocks_unit", "block_length", req.BlockLength, block, period)
+	}
+	violations.checkTodos("", req.Tasks, req.Routines)
+	return violations.err()
+}
+
+func (v *fieldViolations) checkPlanRequest(prefix string, req *pb.PlanRequest) {
+	block := v.checkUnit(prefix+"build_unit", req.BuildUnit, prefix+"block_length", req.BlockLength)
+	period := v.checkUnit(prefix+"period_unit", req.PeriodUnit, prefix+"period_length", req.PeriodLength)
+	v.checkDivides(prefix+"build_unit", prefix+"block_length", req.BlockLength, block, period)
+	if _, ok := planner.StrategyNamed(req.Strategy); !ok {
+		v.add(prefix+"strategy", "%q is not a known strategy", req.Strategy)
+	}
+	if req.TimeBudgetMs < 0 {
+		v.add(prefix+"time_budget_ms", "must not be negative")
+	}
+	if _, ok := overflowPolicies[req.Overflow]; !ok {
+		v.add(prefix+"overflow", "%d is not a known overflow policy", req.Overflow)
+	}
+	if req.Improvement != nil {
+		if req.Improvement.Iterations < 0 {
+			v.add(prefix+"improvement.iterations", "must not be negative")
+		}
+		if req.Improvement.TimeLimitMs < 0 {
+			v.add(prefix+"improvement.time_limit_ms", "must not be negative")
+		}
+	}
+
+	// a capacity per period can stand for n_periods and n_blocks
+	hasCapacities := len(req.PeriodCapacities) > 0
+	if req.NPeriods < 0 || (req.NPeriods == 0 && !hasCapacities) {
+		v.add(prefix+"n_periods", "must be positive")
+	} else if req.NPeriods > planner.PeriodsLimit {
+		v.add(prefix+"n_periods", "must be at most %d", planner.PeriodsLimit)
+	}
+	if req.NBlocks < 0 || (req.NBlocks == 0 && !hasCapacities) {
+		v.add(prefix+"n_blocks", "must be positive")
+	}
+
+	if len(req.PeriodCapacities) > planner.PeriodsLimit {
+		v.add(prefix+"period_capacities", "must list at most %d periods", planner.PeriodsLimit)
+	}
+	for i, capacity := range req.PeriodCapacities {
+		if capacity < 0 {
+			v.add(fmt.Sprintf("%speriod_capacities[%d]", prefix, i), "must not be negative")
+		}
+	}
+
+	nPeriods := int(req.NPeriods)
+	if nPeriods == 0 {
+		nPeriods = len(req.PeriodCapacities)
+	}
+
+	for i, period := range req.BlockedPeriods {
+		if period < 0 || int(period) >= nPeriods {
+			v.add(fmt.Sprintf("%sblocked_periods[%d]", prefix, i), "period %d is outside the plan", period)
+		}
+	}
+
+	for i, slot := range req.BlockedSlots {
+		field := fmt.Sprintf("%sblocked_slots[%d]", prefix, i)
+		if slot == nil {
+			v.add(field, "is required")
+			continue
+		}
+		if slot.Period < 0 || int(slot.Period) >= nPeriods || slot.Block < 0 {
+			v.add(field, "(%d, %d) is outside the plan", slot.Period, slot.Block)
+		}
+	}
+
+	v.checkTodos(prefix, req.Tasks, req.Routines)
+
+	// the least chunk of a task and the block of a routine
+	// have to fit in the longest period
+	longestPeriod := int32(0)
+	if nPeriods > len(req.PeriodCapacities) {
+		longestPeriod = req.NBlocks
+	}
+	for _, capacity := range req.PeriodCapacities {
+		longestPeriod = max(longestPeriod, capacity)
+	}
+	for i, task := range req.Tasks {
+		if task != nil && task.MinChunk > longestPeriod {
+			v.add(fmt.Sprintf("%stasks[%d].min_chunk", prefix, i), "must fit in a period of at most %d blocks", longestPeriod)
+		}
+	}
+	for i, routine := range req.Routines {
+		if routine != nil && routine.Position == "block" && routine.Block >= longestPeriod {
+			v.add(fmt.Sprintf("%sroutines[%d].block", prefix, i), "must be inside a period of at most %d blocks", longestPeriod)
+		}
+		if routine != nil && len(routine.Weekdays) > 0 && !isPlanOfDays(req) {
+			v.add(fmt.Sprintf("%sroutines[%d].weekdays", prefix, i), "only apply to plans of days")
+		}
+	}
+
+	v.checkCalendar(prefix, req)
+}
+
+// checkCalendar validates the fields mapping the periods of a plan to datetimes
+func (v *fieldViolations) checkCalendar(prefix string, req *pb.PlanRequest) {
+	if req.StartWeekday != "" {
+		if _, err := calendar.ParseWeekday(req.StartWeekday); err != nil {
+			v.add(prefix+"start_weekday", "%v", err)
+		} else if req.StartDatetime != "" {
+			v.add(prefix+"start_weekday", "can't be combined with start_datetime")
+		}
+	}
+
+	if req.TimeZone != "" {
+		if _, err := time.LoadLocation(req.TimeZone); err != nil {
+			v.add(prefix+"time_zone", "%q is not an IANA time zone", req.TimeZone)
+		} else if req.StartDatetime == "" {
+			v.add(prefix+"time_zone", "only applies with start_datetime")
+		}
+	}
+	if req.StartDatetime != "" {
+		if _, err := calendar.ParseStart(req.StartDatetime, ""); err != nil {
+			v.add(prefix+"start_datetime", "%v", err)
+		}
+	}
+
+	if len(req.BlockStartTimes) > 0 {
+		if req.StartDatetime == "" {
+			v.add(prefix+"block_start_times", "only apply with start_datetime")
+		}
+		if !isPlanOfDays(req) {
+			v.add(prefix+"block_start_times", "only apply to plans of days")
+		}
+	}
+	var previous *calendar.Clock
+	for i, value := range req.BlockStartTimes {
+		clock, err := calendar.ParseClock(value)
+		if err != nil {
+			v.add(fmt.Sprintf("%sblock_start_times[%d]", prefix, i), "%v", err)
+			continue
+		}
+		if previous != nil && clock.Minutes() <= previous.Minutes() {
+			v.add(fmt.Sprintf("%sblock_start_times[%d]", prefix, i), "must be later than the previous block")
+		}
+		previous = &clock
+	}
+
+	if len(req.SkippedWeekdays) == 0 {
+		return
+	}
+	if !isPlanOfDays(req) {
+		v.add(prefix+"skipped_weekdays", "only apply to plans of days")
+	}
+
+	skipped := make(map[time.Weekday]bool)
+	for i, name := range req.SkippedWeekdays {
+		weekday, err := calendar.ParseWeekday(name)
+		if err != nil {
+			v.add(fmt.Sprintf("%sskipped_weekdays[%d]", prefix, i), "%v", err)
+			continue
+		}
+		skipped[weekday] = true
+	}
+	if len(skipped) == 7 {
+		v.add(prefix+"skipped_weekdays", "must leave a working day")
+	}
+
+	for i, routine := range req.Routines {
+		for j, name := range routine.GetWeekdays() {
+			if weekday, err := calendar.ParseWeekday(name); err == nil && skipped[weekday] {
+				v.add(fmt.Sprintf("%sroutines[%d].weekdays[%d]", prefix, i, j), "%s is a skipped day", name)
+			}
+		}
+	}
+}
+
+// checkUnit validates a unit given by name or, replacing the name, as a
+// length, it returns the zero unit when the unit is invalid
+func (v *fieldViolations) checkUnit(nameField string, name string, lengthField string, length *pb.TimeLength) units.Unit {
+	if length != nil && name != "" {
+		v.add(lengthField, "can't be combined with %s", nameField)
+		return units.Unit{}
+	}
+
+	unit, err := unitFromRequest(name, length)
+	if err != nil && length != nil {
+		v.add(lengthField, "%v", err)
+	} else if err != nil {
+		v.add(nameField, "%v", err)
+	}
+	return unit
+}
+
+// checkDivides checks that a whole number of blocks makes up a period
+func (v *fieldViolations) checkDivides(nameField string, lengthField string, length *pb.TimeLength, block units.Unit, period units.Unit) {
+	if block.IsZero() || period.IsZero() || block.Divides(period) {
+		return
+	}
+	field := nameField
+	if length != nil {
+		field = lengthField
+	}
+	v.add(field, "a block of %s doesn't divide a period of %s", block.Amount(1), period.Amount(1))
+}
+
+func (v *fieldViolations) checkTodos(prefix string, tasks []*pb.Task, routines []*pb.Routine) {
+	taskIds := make(map[string]string)
+	for i, task := range tasks {
+		field := fmt.Sprintf("%stasks[%d]", prefix, i)
+		if task == nil {
+			v.add(field, "is required")
+			continue
+		}
+
+		v.checkTodo(field+".todo", task.Todo, "task", taskIds)
+		if task.Priority < 1 {
+			v.add(field+".priority", "must be at least 1, higher is more urgent")
+		}
+		if task.Deadline != nil && *task.Deadline < 0 {
+			v.add(field+".deadline", "must not be negative")
+		}
+		if task.EarliestStart != nil && *task.EarliestStart < 0 {
+			v.add(field+".earliest_start", "must not be negative")
+		}
+		v.checkChunks(field, task)
+	}
+
+	routineIds := make(map[string]string)
+	for i, routine := range routines {
+		field := fmt.Sprintf("%sroutines[%d]", prefix, i)
+		if routine == nil {
+			v.add(field, "is required")
+			continue
+		}
+
+		v.checkTodo(field+".todo", routine.Todo, "routine", routineIds)
+		switch routine.Position {
+		case "", "start", "end":
+			if routine.Block != 0 {
+				v.add(field+".block", "only applies to the \"block\" position")
+			}
+		case "block":
+			if routine.Block < 0 {
+				v.add(field+".block", "must not be negative")
+			}
+		default:
+			v.add(field+".position", "%q must be \"start\", \"end\" or \"block\"", routine.Position)
+		}
+		v.checkRecurrence(field, routine)
+	}
+}
+
+// checkTodo validates a todo of the given type, ids holds the fields
+// of the todos of the same type checked before it
+func (v *fieldViolations) checkTodo(field string, todo *pb.Todo, _type string, ids map[string]string) {
+	if todo == nil {
+		v.add(field, "is required")
+		return
+	}
+
+	if todo.Id == "" {
+		v.add(field+".id", "is required")
+	} else if other, ok := ids[todo.Id]; ok {
+		v.add(field+".id", "duplicates the id of %s", other)
+	} else {
+		ids[todo.Id] = field
+	}
+
+	if todo.RequiredTime <= 0 || todo.RequiredTime > planner.RequiredTimeLimit {
+		v.add(field+".required_time", "must be from 1 to %d", planner.RequiredTimeLimit)
+	}
+	if todo.Type != "" && todo.Type != _type {
+		v.add(field+".type", "must be %q", _type)
+	}
+}
+
+// checkChunks validates the chunk bounds of a task against its required time
+func (v *fieldViolations) checkChunks(field string, task *pb.Task) {
+	if task.MinChunk == 0 && task.MaxChunk == 0 {
+		return
+	}
+
+	if !task.IsBreakable {
+		v.add(field+".min_chunk", "only applies to breakable tasks")
+		return
+	}
+	if task.MinChunk < 0 {
+		v.add(field+".min_chunk", "must not be negative")
+	}
+	if task.MaxChunk < 0 {
+		v.add(field+".max_chunk", "must not be negative")
+	}
+	if task.MinChunk < 0 || task.MaxChunk < 0 {
+		return
+	}
+
+	if task.MaxChunk > 0 && task.MinChunk > task.MaxChunk {
+		v.add(field+".min_chunk", "must not be more than max_chunk")
+		return
+	}
+
+	requiredTime := task.GetTodo().GetRequiredTime()
+	if requiredTime <= 0 {
+		return
+	}
+	if task.MinChunk > requiredTime {
+		v.add(field+".min_chunk", "must not be more than required_time")
+	} else if !planner.CanSplitIntoChunks(int(requiredTime), int(task.MinChunk), int(task.MaxChunk)) {
+		v.add(field+".max_chunk", "required_time %d can't be split into chunks of %d to %d blocks", requiredTime, max(task.MinChunk, 1), task.MaxChunk)
+	}
+}
+
+// checkRecurrence validates the periods a routine recurs in
+func (v *fieldViolations) checkRecurrence(field string, routine *pb.Routine) {
+	if routine.Every < 0 || routine.Every > planner.PeriodsLimit {
+		v.add(field+".every", "must be from 0 to %d", planner.PeriodsLimit)
+	}
+	if routine.Times < 0 || routine.Times > planner.PeriodsLimit {
+		v.add(field+".times", "must be from 0 to %d", planner.PeriodsLimit)
+	}
+
+	cycle := max(routine.Every, 1)
+	for j, offset := range routine.Offsets {
+		if offset < 0 || offset >= cycle {
+			v.add(fmt.Sprintf("%s.offsets[%d]", field, j), "must be from 0 to %d", cycle-1)
+		}
+	}
+
+	if len(routine.Weekdays) == 0 {
+		return
+	}
+	if routine.Every != 0 || len(routine.Offsets) > 0 {
+		v.add(field+".weekdays", "can't be combined with every or offsets")
+	}
+	for j, name := range routine.Weekdays {
+		if _, err := calendar.ParseWeekday(name); err != nil {
+			v.add(fmt.Sprintf("%s.weekdays[%d]", field, j), "%v", err)
+		}
+	}
+}
+
+// checkPeriods validates the cells of a table sent back with its plan
+func (v *fieldViolations) checkPeriods(prefix string, req *pb.PlanRequest, periods []*pb.Period) {
+	todoIds := map[string]map[string]bool{
+		"task":    {},
+		"routine": {},
+	}
+	for _, task := range req.Tasks {
+		todoIds["task"][task.GetTodo().GetId()] = true
+	}
+	for _, routine := range req.Routines {
+		todoIds["routine"][routine.GetTodo().GetId()] = true
+	}
+
+	for i, period := range periods {
+		for j, cell := range period.GetCells() {
+			field := fmt.Sprintf("%speriods[%d].cells[%d]", prefix, i, j)
+			switch cell.GetType() {
+			case "task", "routine":
+				if !todoIds[cell.GetType()][cell.GetTodoId()] {
+					v.add(field+".todo_id", "%q is not a %s of the plan", cell.GetTodoId(), cell.GetType())
+				}
+			case "blocked", "free":
+			default:
+				v.add(field+".type", "%q must be \"task\", \"routine\", \"blocked\" or \"free\"", cell.GetType())
+			}
+		}
+	}
+}
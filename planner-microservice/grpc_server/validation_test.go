@@ -0,0 +1,182 @@
+package grpc_server
+
+import (
+	pb "planner-microservice/proto"
+	"slices"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// violatedFields returns the fields of the BadRequest detail of an InvalidArgument error
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("error = %v, want %s", err, codes.InvalidArgument)
+	}
+
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+		}
+	}
+	return fields
+}
+
+func checkViolations(t *testing.T, err error, want []string) {
+	t.Helper()
+	if got := violatedFields(t, err); !slices.Equal(got, want) {
+		t.Errorf("violated fields = %q, want %q", got, want)
+	}
+}
+
+func TestValidateLimits(t *testing.T) {
+	task := &pb.Task{Todo: &pb.Todo{Id: "essay", RequiredTime: 300000000}, Priority: 1}
+	routine := &pb.Routine{Todo: &pb.Todo{Id: "gym", RequiredTime: 1}, Every: 300000000, Times: 300000000}
+
+	err := validatePlanRequest(&pb.PlanRequest{
+		BuildUnit:        "hour",
+		PeriodUnit:       "day",
+		NPeriods:         300000000,
+		NBlocks:          24,
+		PeriodCapacities: make([]int32, 10001),
+		Tasks:            []*pb.Task{task},
+		Routines:         []*pb.Routine{routine},
+	})
+	checkViolations(t, err, []string{
+		"n_periods",
+		"period_capacities",
+		"tasks[0].todo.required_time",
+		"routines[0].every",
+		"routines[0].times",
+	})
+
+	err = validateTimeConstraintsRequest(&pb.TimeConstraintsRequest{
+		BlocksUnit: "hour",
+		Tasks:      []*pb.Task{task},
+		Routines:   []*pb.Routine{routine},
+	})
+	checkViolations(t, err, []string{
+		"tasks[0].todo.required_time",
+		"routines[0].every",
+		"routines[0].times",
+	})
+}
+
+func TestValidatePlanRequest(t *testing.T) {
+	// request returns a valid request changed by edit
+	request := func(edit func(req *pb.PlanRequest)) *pb.PlanRequest {
+		req := &pb.PlanRequest{
+			BuildUnit:  "hour",
+			PeriodUnit: "day",
+			NPeriods:   3,
+			NBlocks:    8,
+			Tasks: []*pb.Task{
+				{Todo: &pb.Todo{Id: "essay", Title: "Essay", RequiredTime: 4}, Priority: 1},
+				{Todo: &pb.Todo{Id: "mail", Title: "Mail", RequiredTime: 1}, Priority: 2},
+			},
+			Routines: []*pb.Routine{{Todo: &pb.Todo{Id: "gym", Title: "Gym", RequiredTime: 1}}},
+		}
+		edit(req)
+		return req
+	}
+
+	tests := []struct {
+		name string
+		req  *pb.PlanRequest
+		want []string
+	}{
+		{
+			name: "valid",
+			req:  request(func(req *pb.PlanRequest) {}),
+		},
+		{
+			name: "nil todos",
+			req: request(func(req *pb.PlanRequest) {
+				req.Tasks[0] = nil
+				req.Tasks[1].Todo = nil
+				req.Routines[0].Todo = nil
+			}),
+			want: []string{"tasks[0]", "tasks[1].todo", "routines[0].todo"},
+		},
+		{
+			name: "zero and negative required time",
+			req: request(func(req *pb.PlanRequest) {
+				req.Tasks[0].Todo.RequiredTime = 0
+				req.Routines[0].Todo.RequiredTime = -1
+			}),
+			want: []string{"tasks[0].todo.required_time", "routines[0].todo.required_time"},
+		},
+		{
+			name: "priority below 1",
+			req: request(func(req *pb.PlanRequest) {
+				req.Tasks[0].Priority = 0
+				req.Tasks[1].Priority = -3
+			}),
+			want: []string{"tasks[0].priority", "tasks[1].priority"},
+		},
+		{
+			name: "duplicate ids",
+			req: request(func(req *pb.PlanRequest) {
+				req.Tasks[1].Todo.Id = "essay"
+				req.Routines = append(req.Routines, &pb.Routine{Todo: &pb.Todo{Id: "gym", RequiredTime: 1}})
+			}),
+			want: []string{"tasks[1].todo.id", "routines[1].todo.id"},
+		},
+		{
+			name: "unknown units",
+			req: request(func(req *pb.PlanRequest) {
+				req.BuildUnit = "horu"
+				req.PeriodUnit = "year"
+			}),
+			want: []string{"build_unit", "period_unit"},
+		},
+		{
+			name: "no periods or blocks",
+			req: request(func(req *pb.PlanRequest) {
+				req.NPeriods = 0
+				req.NBlocks = -1
+			}),
+			want: []string{"n_periods", "n_blocks"},
+		},
+		{
+			name: "all at once",
+			req: request(func(req *pb.PlanRequest) {
+				req.BuildUnit = "horu"
+				req.NPeriods = -2
+				req.NBlocks = 0
+				req.Tasks[0].Todo.RequiredTime = -4
+				req.Tasks[1].Todo.Id = "essay"
+				req.Tasks[1].Priority = 0
+				req.Routines[0] = nil
+			}),
+			want: []string{
+				"build_unit",
+				"n_periods",
+				"n_blocks",
+				"tasks[0].todo.required_time",
+				"tasks[1].todo.id",
+				"tasks[1].priority",
+				"routines[0]",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePlanRequest(tt.req)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("validatePlanRequest() error = %v", err)
+				}
+				return
+			}
+			checkViolations(t, err, tt.want)
+		})
+	}
+}
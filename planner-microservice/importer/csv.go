@@ -0,0 +1,127 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"planner-microservice/units"
+	"strings"
+)
+
+// csvColumns maps the accepted header names to the field they hold
+var csvColumns = map[string]string{
+	"id":            "id",
+	"title":         "title",
+	"name":          "title",
+	"content":       "title",
+	"description":   "description",
+	"notes":         "description",
+	"type":          "type",
+	"duration":      "duration",
+	"required_time": "duration",
+	"time":          "duration",
+	"priority":      "priority",
+	"breakable":     "breakable",
+	"is_breakable":  "breakable",
+}
+
+// CSV reads a todo per row of a CSV document whose first row names its
+// columns: title (required), id, description, type ("task" or "routine"),
+// duration (e.g. "3h" or "2 blocks"), priority and breakable (yes or no)
+func CSV(content string, block units.Unit) Result {
+	var result Result
+
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			result.fail(1, "missing header row")
+		} else {
+			result.fail(1, "%v", err)
+		}
+		return result
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		field, ok := csvColumns[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			result.fail(1, "unknown column %q", name)
+			continue
+		}
+		columns[field] = i
+	}
+	if _, ok := columns["title"]; !ok {
+		result.fail(1, "missing title column")
+		return result
+	}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			line := 0
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				line = parseErr.StartLine
+			}
+			result.fail(line, "%v", err)
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+
+		value := func(field string) string {
+			if i, ok := columns[field]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		it := item{
+			id:          value("id"),
+			title:       value("title"),
+			description: value("description"),
+		}
+		failed := false
+		fail := func(format string, args ...any) {
+			result.fail(line, format, args...)
+			failed = true
+		}
+
+		switch strings.ToLower(value("type")) {
+		case "", "task":
+		case "routine":
+			it.routine = true
+		default:
+			fail("%q is not a type (task or routine)", value("type"))
+		}
+		if duration := value("duration"); duration != "" {
+			if it.minutes, it.blocks, err = parseDuration(duration); err != nil {
+				fail("%v", err)
+			}
+		}
+		if priority := value("priority"); priority != "" {
+			if it.priority, err = parsePriority(priority); err != nil {
+				fail("%v", err)
+			}
+		}
+		if flag := value("breakable"); flag != "" {
+			breakable, err := parseBool(flag)
+			if err != nil {
+				fail("%v", err)
+			}
+			it.breakable = &breakable
+		}
+
+		if !failed {
+			result.add(line, it, block)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,78 @@
+package importer
+
+import (
+	"planner-microservice/units"
+	"testing"
+)
+
+func TestCSV(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		wantTodos  []string
+		wantErrors []int
+	}{
+		{
+			name: "tasks and routines",
+			content: "id,title,duration,priority,type,breakable\n" +
+				"e1,Essay,3h,high,task,yes\n" +
+				"r1,Gym,1h,,routine,\n" +
+				",Slides,90m,low,,no\n",
+			wantTodos: []string{
+				"task e1 Essay 3 3 true",
+				"task " + lineID(4, "Slides") + " Slides 2 1 false",
+				"routine r1 Gym 1",
+			},
+		},
+		{
+			name: "header aliases and defaults",
+			content: "Name,Notes\n" +
+				"Read,chapter 3\n",
+			wantTodos: []string{
+				"task " + lineID(2, "Read") + " Read 1 2 false",
+			},
+		},
+		{
+			name: "malformed rows",
+			content: "title,duration,priority,type,breakable\n" +
+				"Essay,3x,,,\n" +
+				"Slides,1h,huge,,\n" +
+				"Gym,1h,,habit,\n" +
+				"Read,1h,,,maybe\n" +
+				",1h,,,\n" +
+				"Notes,2 blocks,,,\n",
+			wantTodos: []string{
+				"task " + lineID(7, "Notes") + " Notes 2 2 true",
+			},
+			wantErrors: []int{2, 3, 4, 5, 6},
+		},
+		{
+			name: "unclosed quote",
+			content: "title,duration\n" +
+				"\"Essay,3h\n",
+			wantErrors: []int{2},
+		},
+		{
+			name:       "missing title column",
+			content:    "duration,priority\n3h,high\n",
+			wantErrors: []int{1},
+		},
+		{
+			name:       "unknown column",
+			content:    "title,colour\nEssay,red\n",
+			wantTodos:  []string{"task " + lineID(2, "Essay") + " Essay 1 2 false"},
+			wantErrors: []int{1},
+		},
+		{
+			name:       "empty document",
+			content:    "",
+			wantErrors: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkResult(t, CSV(tt.content, units.Hour), tt.wantTodos, tt.wantErrors)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package importer
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"planner-microservice/planner"
+	"planner-microservice/units"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// LineError is a todo of the imported document that couldn't be read
+type LineError struct {
+	Line    int
+	Message string
+}
+
+func (e LineError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// Result holds the todos read from a document, a line that fails doesn't stop the others
+type Result struct {
+	Tasks    []planner.Task
+	Routines []planner.Routine
+	Errors   []LineError
+}
+
+func (r *Result) fail(line int, format string, args ...any) {
+	r.Errors = append(r.Errors, LineError{Line: line, Message: fmt.Sprintf(format, args...)})
+}
+
+// item is a todo read from a document before its time is turned into blocks
+type item struct {
+	id          string
+	title       string
+	description string
+	minutes     int // 0 when the document doesn't tell
+	blocks      int // set instead of minutes when the document counts blocks
+	priority    int // 0 when the document doesn't tell
+	breakable   *bool
+	routine     bool
+}
+
+// add infers what the document leaves out and adds the item as a task or a routine:
+// a block when there's no time, normal priority, and only tasks longer than a block are breakable
+func (r *Result) add(line int, it item, block units.Unit) {
+	if strings.TrimSpace(it.title) == "" {
+		r.fail(line, "todo has no title")
+		return
+	}
+
+	requiredTime := max(it.blocks, 1)
+	if it.minutes > 0 {
+		requiredTime = max(int(math.Ceil(float64(it.minutes)/float64(block.Minutes()))), 1)
+	}
+
+	// the same document always gives the same ids
+	id := it.id
+	if id == "" {
+		id = lineID(line, it.title)
+	}
+
+	if it.routine {
+		routine, err := planner.NewRoutine(id, it.title, it.description, requiredTime)
+		if err != nil {
+			r.fail(line, "%v", err)
+			return
+		}
+		r.Routines = append(r.Routines, *routine)
+		return
+	}
+
+	priority := it.priority
+	if priority == 0 {
+		priority = 2
+	}
+	breakable := requiredTime > 1
+	if it.breakable != nil {
+		breakable = *it.breakable
+	}
+
+	task, err := planner.NewTask(id, it.title, it.description, requiredTime, priority, breakable)
+	if err != nil {
+		r.fail(line, "%v", err)
+		return
+	}
+	r.Tasks = append(r.Tasks, *task)
+}
+
+// lineID identifies a todo the document gives no id by its line and a
+// hash of its title, like "line-3-5d41402a"
+func lineID(line int, title string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(title)))
+	return fmt.Sprintf("line-%d-%x", line, sum[:4])
+}
+
+// annotate sets what an attribute of a todo tells: its duration, its
+// priority, "routine", "breakable" or "unbreakable"
+func (it *item) annotate(attribute string) error {
+	switch strings.ToLower(attribute) {
+	case "routine", "daily":
+		it.routine = true
+		return nil
+	case "breakable":
+		breakable := true
+		it.breakable = &breakable
+		return nil
+	case "unbreakable":
+		breakable := false
+		it.breakable = &breakable
+		return nil
+	}
+
+	if priority, err := parsePriority(attribute); err == nil {
+		it.priority = priority
+		return nil
+	}
+
+	minutes, blocks, err := parseDuration(attribute)
+	if err != nil {
+		return fmt.Errorf("%q is not a duration, a priority, routine, breakable or unbreakable", attribute)
+	}
+	it.minutes, it.blocks = minutes, blocks
+	return nil
+}
+
+// durationPart matches a single amount of time like "1.5h" or "30 min"
+var durationPart = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*(minutes?|mins|min|m|hours?|hrs|hr|h|days?|d|weeks?|w|blocks?)`)
+
+// durationUnits holds the units durations are written in
+var durationUnits = map[string]units.Unit{
+	"m": units.Minute, "min": units.Minute, "mins": units.Minute, "minute": units.Minute, "minutes": units.Minute,
+	"h": units.Hour, "hr": units.Hour, "hrs": units.Hour, "hour": units.Hour, "hours": units.Hour,
+	"d": units.Day, "day": units.Day, "days": units.Day,
+	"w": units.Week, "week": units.Week, "weeks": units.Week,
+}
+
+// parseDuration reads a duration like "3h", "1h30m" or "2 blocks", it
+// returns either its minutes or its blocks
+func parseDuration(value string) (int, int, error) {
+	rest := strings.ToLower(strings.TrimSpace(value))
+	if rest == "" {
+		return 0, 0, fmt.Errorf("empty duration")
+	}
+
+	var total float64
+	for rest != "" {
+		match := durationPart.FindStringSubmatch(rest)
+		if match == nil {
+			return 0, 0, fmt.Errorf("%q is not a duration (e.g. 3h, 90m, 1h30m, 2 blocks)", value)
+		}
+		amount, _ := strconv.ParseFloat(match[1], 64)
+		if strings.HasPrefix(match[2], "block") {
+			if total > 0 || strings.TrimSpace(rest[len(match[0]):]) != "" || amount != math.Trunc(amount) {
+				return 0, 0, fmt.Errorf("%q is not a whole number of blocks", value)
+			}
+			return 0, int(amount), nil
+		}
+
+		total += amount * float64(durationUnits[match[2]].Minutes())
+		rest = strings.TrimSpace(rest[len(match[0]):])
+	}
+
+	if total <= 0 {
+		return 0, 0, fmt.Errorf("%q is not a positive duration", value)
+	}
+	return int(math.Ceil(total)), 0, nil
+}
+
+// parsePriority reads a priority written as a word, high to low being 3 to
+// 1, or as a positive weight
+func parsePriority(value string) (int, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	switch value {
+	case "high", "urgent":
+		return 3, nil
+	case "normal", "medium":
+		return 2, nil
+	case "low":
+		return 1, nil
+	}
+	if priority, err := strconv.Atoi(value); err == nil && priority >= 1 {
+		return priority, nil
+	}
+	return 0, fmt.Errorf("%q is not a priority (high, normal, low or a positive number)", value)
+}
+
+// parseBool reads yes/no flags of spreadsheets
+func parseBool(value string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "yes", "y", "1":
+		return true, nil
+	case "false", "no", "n", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("%q is not yes or no", value)
+}
@@ -0,0 +1,79 @@
+package importer
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+)
+
+// summary writes the todos of a result as "task id title blocks priority
+// breakable" and "routine id title blocks", and the lines of its errors
+func summary(result Result) ([]string, []int) {
+	var todos []string
+	for _, task := range result.Tasks {
+		todos = append(todos, fmt.Sprintf("task %s %s %d %d %v", task.Id, task.Title, task.RequiredTime, task.Priority, task.IsBreakable))
+	}
+	for _, routine := range result.Routines {
+		todos = append(todos, fmt.Sprintf("routine %s %s %d", routine.Id, routine.Title, routine.RequiredTime))
+	}
+	var lines []int
+	for _, err := range result.Errors {
+		lines = append(lines, err.Line)
+	}
+	return todos, lines
+}
+
+func checkResult(t *testing.T, result Result, wantTodos []string, wantErrors []int) {
+	t.Helper()
+	todos, lines := summary(result)
+	if !slices.Equal(todos, wantTodos) {
+		t.Errorf("todos = %q, want %q", todos, wantTodos)
+	}
+	if !slices.Equal(lines, wantErrors) {
+		t.Errorf("errors on lines %v, want %v (%v)", lines, wantErrors, result.Errors)
+	}
+}
+
+func TestLineID(t *testing.T) {
+	id := lineID(3, "Essay")
+	if !regexp.MustCompile(`^line-3-[0-9a-f]{8}$`).MatchString(id) {
+		t.Errorf("lineID() = %q, want line-3- and 8 hex digits", id)
+	}
+	if lineID(3, " Essay ") != id {
+		t.Error("lineID() depends on the spaces around the title")
+	}
+	if lineID(4, "Essay") == id || lineID(3, "Slides") == id {
+		t.Error("lineID() is the same for another line or title")
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		value       string
+		wantMinutes int
+		wantBlocks  int
+		wantErr     bool
+	}{
+		{value: "3h", wantMinutes: 180},
+		{value: "1h30m", wantMinutes: 90},
+		{value: "1.5 hours", wantMinutes: 90},
+		{value: "2 blocks", wantBlocks: 2},
+		{value: "1d", wantMinutes: 1440},
+		{value: "", wantErr: true},
+		{value: "3x", wantErr: true},
+		{value: "1.5 blocks", wantErr: true},
+		{value: "1h 2 blocks", wantErr: true},
+		{value: "0h", wantErr: true},
+	}
+	for _, tt := range tests {
+		minutes, blocks, err := parseDuration(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseDuration(%q) error = %v, want error %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if minutes != tt.wantMinutes || blocks != tt.wantBlocks {
+			t.Errorf("parseDuration(%q) = %d minutes, %d blocks, want %d, %d", tt.value, minutes, blocks, tt.wantMinutes, tt.wantBlocks)
+		}
+	}
+}
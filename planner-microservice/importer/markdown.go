@@ -0,0 +1,64 @@
+package importer
+
+import (
+	"bufio"
+	"planner-microservice/units"
+	"regexp"
+	"strings"
+)
+
+// checklistItem matches a task list item like "- [ ] Title (3h, high)",
+// the annotation in parentheses at the end is optional
+var checklistItem = regexp.MustCompile(`^\s*[-*+]\s+\[([ xX])\]\s+(.*?)\s*(?:\(([^()]*)\))?\s*$`)
+
+// Markdown reads the unchecked items of Markdown task lists, the
+// annotation of an item holds its duration, priority and flags:
+//
+//   - [ ] Read chapter 3 (3h, high)
+//   - [ ] Gym (1h, routine)
+//   - [ ] Write report (2 blocks, low, unbreakable)
+//
+// Other lines and checked items are skipped.
+func Markdown(content string, block units.Unit) Result {
+	var result Result
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		match := checklistItem.FindStringSubmatch(scanner.Text())
+		if match == nil || match[1] != " " {
+			continue
+		}
+
+		it := item{title: match[2]}
+		var errs []error
+		attributes := 0
+		for _, attribute := range strings.Split(match[3], ",") {
+			attribute = strings.TrimSpace(attribute)
+			if attribute == "" {
+				continue
+			}
+			attributes++
+			if err := it.annotate(attribute); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		// parentheses without a single attribute are part of the title
+		if attributes > 0 && len(errs) == attributes {
+			it = item{title: strings.TrimSpace(match[2] + " (" + match[3] + ")")}
+			errs = nil
+		}
+		for _, err := range errs {
+			result.fail(line, "%v", err)
+		}
+		if len(errs) == 0 {
+			result.add(line, it, block)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		result.fail(0, "%v", err)
+	}
+	return result
+}
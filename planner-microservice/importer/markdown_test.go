@@ -0,0 +1,58 @@
+package importer
+
+import (
+	"planner-microservice/units"
+	"testing"
+)
+
+func TestMarkdown(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		wantTodos  []string
+		wantErrors []int
+	}{
+		{
+			name: "checklist",
+			content: "# Week\n" +
+				"- [ ] Read chapter 3 (3h, high)\n" +
+				"- [x] Done already (1h)\n" +
+				"* [ ] Gym (1h, routine)\n" +
+				"Some notes\n" +
+				"+ [ ] Write report (2 blocks, low, unbreakable)\n",
+			wantTodos: []string{
+				"task " + lineID(2, "Read chapter 3") + " Read chapter 3 3 3 true",
+				"task " + lineID(6, "Write report") + " Write report 2 1 false",
+				"routine " + lineID(4, "Gym") + " Gym 1",
+			},
+		},
+		{
+			name:    "parentheses that aren't attributes",
+			content: "- [ ] Call (the bank)\n",
+			wantTodos: []string{
+				"task " + lineID(1, "Call (the bank)") + " Call (the bank) 1 2 false",
+			},
+		},
+		{
+			name: "malformed annotations",
+			content: "- [ ] Essay (3h, huge)\n" +
+				"- [ ] Slides (1.5 blocks, high)\n" +
+				"- [ ] Read (30m)\n",
+			wantTodos: []string{
+				"task " + lineID(3, "Read") + " Read 1 2 false",
+			},
+			wantErrors: []int{1, 2},
+		},
+		{
+			name:       "no title",
+			content:    "- [ ]  (1h)\n",
+			wantErrors: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkResult(t, Markdown(tt.content, units.Hour), tt.wantTodos, tt.wantErrors)
+		})
+	}
+}
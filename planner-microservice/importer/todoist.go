@@ -0,0 +1,128 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/json"
+	"planner-microservice/units"
+	"strings"
+)
+
+// todoistItem is a task of a Todoist export, of the REST and Sync APIs alike
+type todoistItem struct {
+	ID          json.RawMessage `json:"id"`
+	Content     string          `json:"content"`
+	Description string          `json:"description"`
+	Priority    int             `json:"priority"`
+	Checked     bool            `json:"checked"`
+	IsCompleted bool            `json:"is_completed"`
+	Labels      []string        `json:"labels"`
+	Due         *struct {
+		IsRecurring bool `json:"is_recurring"`
+	} `json:"due"`
+	Duration *struct {
+		Amount int    `json:"amount"`
+		Unit   string `json:"unit"`
+	} `json:"duration"`
+}
+
+// todoistPriorities maps Todoist priorities, 4 being the most urgent, to the
+// planner's, Todoist's default priority 1 is the planner's normal one
+var todoistPriorities = map[int]int{4: 3, 3: 2, 2: 1, 1: 2}
+
+// Todoist reads the open tasks of a Todoist export, either the array of
+// tasks of the REST API or an object holding them in "items" or "tasks".
+// Recurring tasks are routines, labels are read like Markdown attributes
+// (e.g. "2h" or "unbreakable") and labels that aren't attributes are skipped.
+func Todoist(content string, block units.Unit) Result {
+	var result Result
+
+	data := []byte(content)
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	lineAt := func(offset int64) int {
+		return bytes.Count(data[:min(int(offset), len(data))], []byte("\n")) + 1
+	}
+
+	if !todoistSeekItems(decoder) {
+		result.fail(lineAt(decoder.InputOffset()), "expected an array of tasks or an object with items")
+		return result
+	}
+
+	for decoder.More() {
+		// the offset points at the end of the previous value, skip to the item itself
+		offset := decoder.InputOffset()
+		for offset < int64(len(data)) && strings.ContainsRune(" \t\r\n,", rune(data[offset])) {
+			offset++
+		}
+		line := lineAt(offset)
+
+		var task todoistItem
+		if err := decoder.Decode(&task); err != nil {
+			result.fail(line, "invalid task: %v", err)
+			return result
+		}
+		if task.Checked || task.IsCompleted {
+			continue
+		}
+
+		it := item{
+			id:          strings.Trim(string(task.ID), `"`),
+			title:       task.Content,
+			description: task.Description,
+			priority:    todoistPriorities[task.Priority],
+			routine:     task.Due != nil && task.Due.IsRecurring,
+		}
+		if it.id == "null" {
+			it.id = ""
+		}
+		for _, label := range task.Labels {
+			// labels that aren't attributes are the user's own
+			_ = it.annotate(label)
+		}
+
+		if task.Duration != nil && task.Duration.Amount > 0 {
+			switch task.Duration.Unit {
+			case "minute":
+				it.minutes, it.blocks = task.Duration.Amount, 0
+			case "day":
+				it.minutes, it.blocks = task.Duration.Amount*units.Day.Minutes(), 0
+			default:
+				result.fail(line, "%q is not a duration unit (minute or day)", task.Duration.Unit)
+				continue
+			}
+		}
+
+		result.add(line, it, block)
+	}
+
+	return result
+}
+
+// todoistSeekItems moves the decoder into the array of tasks of the export
+func todoistSeekItems(decoder *json.Decoder) bool {
+	token, err := decoder.Token()
+	if err != nil {
+		return false
+	}
+	if token == json.Delim('[') {
+		return true
+	}
+	if token != json.Delim('{') {
+		return false
+	}
+
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return false
+		}
+		if key == "items" || key == "tasks" {
+			token, err := decoder.Token()
+			return err == nil && token == json.Delim('[')
+		}
+		var skipped json.RawMessage
+		if err := decoder.Decode(&skipped); err != nil {
+			return false
+		}
+	}
+	return false
+}
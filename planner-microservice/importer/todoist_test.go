@@ -0,0 +1,71 @@
+package importer
+
+import (
+	"planner-microservice/units"
+	"testing"
+)
+
+func TestTodoist(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		wantTodos  []string
+		wantErrors []int
+	}{
+		{
+			name: "rest api",
+			content: `[
+  {"id": "101", "content": "Essay", "priority": 4, "duration": {"amount": 180, "unit": "minute"}},
+  {"id": "102", "content": "Gym", "due": {"is_recurring": true}, "labels": ["1h"]},
+  {"id": "103", "content": "Done", "is_completed": true},
+  {"id": "104", "content": "Slides", "labels": ["unbreakable", "work", "2 blocks"]}
+]`,
+			wantTodos: []string{
+				"task 101 Essay 3 3 true",
+				"task 104 Slides 2 2 false",
+				"routine 102 Gym 1",
+			},
+		},
+		{
+			name: "sync api",
+			content: `{"sync_token": "x", "items": [
+  {"id": 7, "content": "Read", "priority": 2, "checked": false},
+  {"id": null, "content": "Call", "checked": false},
+  {"id": 9, "content": "Old", "checked": true}
+]}`,
+			wantTodos: []string{
+				"task 7 Read 1 1 false",
+				"task " + lineID(3, "Call") + " Call 1 2 false",
+			},
+		},
+		{
+			name: "malformed tasks",
+			content: `[
+  {"id": "1", "content": "Essay", "duration": {"amount": 2, "unit": "week"}},
+  {"id": "2", "content": ""},
+  {"id": "3", "content": "Read", "duration": {"amount": 1, "unit": "day"}},
+  {"id": "4", "content": 5}
+]`,
+			wantTodos: []string{
+				"task 3 Read 24 2 true",
+			},
+			wantErrors: []int{2, 3, 5},
+		},
+		{
+			name:       "not an export",
+			content:    `{"projects": []}`,
+			wantErrors: []int{1},
+		},
+		{
+			name:       "not json",
+			content:    "Essay, 3h",
+			wantErrors: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkResult(t, Todoist(tt.content, units.Hour), tt.wantTodos, tt.wantErrors)
+		})
+	}
+}
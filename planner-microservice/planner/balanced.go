@@ -0,0 +1,73 @@
+package planner
+
+// balanced fills the periods like water fills vessels: every block of a task
+// goes in the least loaded period of its window, evening out the load
+type balanced struct{}
+
+func (balanced) Name() string {
+	return "balanced"
+}
+
+func (balanced) Description() string {
+	return "Evens out the load, every block goes in the least loaded period it can go in"
+}
+
+func (b balanced) placeTasks(p *Planner, tasks []Task) error {
+	for _, task := range dependencyOrder(priorityOrder(p, tasks)) {
+		if err := b.placeTask(p, task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (balanced) placeTask(p *Planner, task Task) error {
+	least, most := chunkBounds(task, p.wholeSize(task))
+	start, end := p.taskWindow(task)
+
+	// blocks of the task given to every period of its window
+	allotted := make(map[int]int)
+	load := func(index int) float64 {
+		return float64(len(p.table[index])+allotted[index]) / float64(p.capacity(index))
+	}
+
+	for task.RequiredTime > 0 {
+		best, bestBlocks := -1, 0
+		for i := start; i <= end && i < len(p.table); i++ {
+			// a period starts with a whole chunk, a single block is added to it after
+			blocks := 1
+			if !task.IsBreakable {
+				blocks = task.RequiredTime
+			} else if allotted[i]+p.taskBlocksIn(task.Id, i) == 0 {
+				blocks = least
+			}
+
+			room := p.capacity(i) - len(p.table[i]) - allotted[i]
+			if blocks > task.RequiredTime || blocks > room || p.taskBlocksIn(task.Id, i)+allotted[i]+blocks > most ||
+				!p.fitsWhole(task.Id, i, allotted[i]+blocks) {
+				continue
+			}
+			if best == -1 || load(i) < load(best) {
+				best, bestBlocks = i, blocks
+			}
+		}
+		if best == -1 {
+			break
+		}
+
+		allotted[best] += bestBlocks
+		task.RequiredTime -= bestBlocks
+	}
+
+	for i := start; i <= end && i < len(p.table); i++ {
+		p.pushBlocks(task, i, allotted[i])
+	}
+
+	// the window is full, the greedy strategy makes room for what's left
+	if task.RequiredTime > 0 {
+		if err := p.pushLeftover(task); err != nil {
+			return p.leaveUnscheduled(task, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package planner
+
+// SetPeriodCapacities gives every period its own number of blocks instead of
+// n_blocks, periods past the end of capacities (or appended to the plan) keep n_blocks
+func (p *Planner) SetPeriodCapacities(capacities []int) {
+	p.capacities = capacities
+
+	// appended periods get the largest capacity when n_blocks wasn't given
+	if p.n_blocks < 1 {
+		for _, capacity := range capacities {
+			p.n_blocks = max(p.n_blocks, capacity)
+		}
+	}
+}
+
+// SetBlockedPeriods marks whole periods where nothing can be placed
+func (p *Planner) SetBlockedPeriods(periods []int) {
+	p.blocked_periods = make(map[int]bool, len(periods))
+	for _, period := range periods {
+		p.blocked_periods[period] = true
+	}
+}
+
+// SetBlockedSlots marks single blocks where nothing can be placed
+func (p *Planner) SetBlockedSlots(slots []Slot) {
+	p.blocked_slots = make(map[Slot]bool, len(slots))
+	for _, slot := range slots {
+		p.blocked_slots[slot] = true
+	}
+}
+
+// periodLength returns the number of blocks in a period, blocked or not
+func (p *Planner) periodLength(index int) int {
+	if index < len(p.capacities) {
+		return p.capacities[index]
+	}
+	return p.n_blocks
+}
+
+// capacity returns the number of blocks that can be used in a period
+func (p *Planner) capacity(index int) int {
+	if p.blocked_periods[index] {
+		return 0
+	}
+
+	length := p.periodLength(index)
+	capacity := length
+	for slot := range p.blocked_slots {
+		if slot.Period == index && slot.Block < length {
+			capacity--
+		}
+	}
+	return capacity
+}
+
+// reservedBlocks returns the blocks a period loses before any task is placed:
+// its pinned cells and routines, a routine is only placed where the capacity
+// left can hold all of it
+func (p *Planner) reservedBlocks(index int) int {
+	used := len(p.pinnedSlotsIn(index))
+	for _, routine := range p.routines {
+		missing := p.missingRoutineTime(routine, index)
+		if used+missing <= p.capacity(index) {
+			used += missing
+		}
+	}
+	return used
+}
+
+// freeBlocks returns the blocks left for tasks in a period
+func (p *Planner) freeBlocks(index int) int {
+	return p.capacity(index) - p.reservedBlocks(index)
+}
+
+// ExceededPeriods returns the indexes of the periods that couldn't hold all the routines
+func (p *Planner) ExceededPeriods() []int {
+	return p.exceeded_periods
+}
@@ -0,0 +1,163 @@
+package planner
+
+import (
+	"errors"
+	"planner-microservice/units"
+	"slices"
+	"testing"
+)
+
+func TestPeriodCapacities(t *testing.T) {
+	tasks := []Task{
+		{Todo: Todo{Id: "essay", Title: "essay", RequiredTime: 4}, Priority: 2, IsBreakable: true},
+		{Todo: Todo{Id: "slides", Title: "slides", RequiredTime: 3}, Priority: 1},
+	}
+	routines := []Routine{{Todo: Todo{Id: "walk", Title: "walk", RequiredTime: 2}, Position: "start"}}
+	// a long day, a short one with no room for the walk, a holiday and a usual day
+	capacities := []int{6, 1, 0, 4}
+
+	for _, strategy := range Strategies() {
+		t.Run(strategy.Name(), func(t *testing.T) {
+			p := NewPlanner(units.Hour, units.Day, tasks, routines, 4, 0)
+			p.SetPeriodCapacities(capacities)
+			p.SetStrategy(strategy)
+			table, err := p.GenerateTable()
+			if err != nil {
+				t.Fatalf("GenerateTable() error = %v", err)
+			}
+
+			if len(table) != len(capacities) {
+				t.Fatalf("GenerateTable() has %d periods, want %d", len(table), len(capacities))
+			}
+			for i, period := range table {
+				used := 0
+				for _, cell := range period {
+					if cell.Type == "task" || cell.Type == "routine" {
+						used++
+					}
+				}
+				if used > capacities[i] {
+					t.Errorf("period %d holds %d blocks, its capacity is %d", i, used, capacities[i])
+				}
+			}
+			for _, task := range tasks {
+				if got := len(p.slotsOf(task.Id, false)); got != task.RequiredTime {
+					t.Errorf("%s has %d blocks, want %d", task.Id, got, task.RequiredTime)
+				}
+			}
+			if got := p.ExceededPeriods(); !slices.Equal(got, []int{1, 2}) {
+				t.Errorf("ExceededPeriods() = %v, want [1 2]", got)
+			}
+		})
+	}
+}
+
+func TestCapacityOverflow(t *testing.T) {
+	tasks := []Task{
+		{Todo: Todo{Id: "essay", Title: "essay", RequiredTime: 6}, Priority: 2, IsBreakable: true},
+		{Todo: Todo{Id: "mail", Title: "mail", RequiredTime: 1}, Priority: 1, IsBreakable: true},
+	}
+	routines := []Routine{{Todo: Todo{Id: "walk", Title: "walk", RequiredTime: 1}, Position: "start"}}
+	capacities := []int{3, 0, 3}
+
+	t.Run("grow", func(t *testing.T) {
+		p := NewPlanner(units.Hour, units.Day, tasks, routines, 3, 3)
+		p.SetPeriodCapacities(capacities)
+		table, err := p.GenerateTable()
+		if err != nil {
+			t.Fatalf("GenerateTable() error = %v", err)
+		}
+		// the appended period holds n_blocks blocks
+		if got := p.AppendedPeriods(); !slices.Equal(got, []int{3}) {
+			t.Errorf("AppendedPeriods() = %v, want [3]", got)
+		}
+		if len(table) != 4 || len(table[3]) != 3 {
+			t.Errorf("GenerateTable() = %v, want 4 periods, the last of 3 blocks", table)
+		}
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		p := NewPlanner(units.Hour, units.Day, tasks, routines, 3, 3)
+		p.SetPeriodCapacities(capacities)
+		p.SetOverflow(OverflowStrict)
+		_, err := p.GenerateTable()
+		var capacityErr *CapacityError
+		if !errors.As(err, &capacityErr) {
+			t.Fatalf("GenerateTable() error = %v, want a *CapacityError", err)
+		}
+	})
+
+	t.Run("best effort", func(t *testing.T) {
+		p := NewPlanner(units.Hour, units.Day, tasks, routines, 3, 3)
+		p.SetPeriodCapacities(capacities)
+		p.SetOverflow(OverflowBestEffort)
+		if _, err := p.GenerateTable(); err != nil {
+			t.Fatalf("GenerateTable() error = %v", err)
+		}
+
+		// the walk of the holiday and the 3 blocks of tasks past the 4 left for them are left out
+		want := []Unscheduled{
+			{Type: "routine", TodoId: "walk", Missing: 1, Periods: []int{1}},
+			{Type: "task", TodoId: "essay", Missing: 2},
+			{Type: "task", TodoId: "mail", Missing: 1},
+		}
+		got := p.Unscheduled()
+		if !slices.EqualFunc(got, want, func(a Unscheduled, b Unscheduled) bool {
+			return a.Type == b.Type && a.TodoId == b.TodoId && a.Missing == b.Missing && slices.Equal(a.Periods, b.Periods)
+		}) {
+			t.Errorf("Unscheduled() = %+v, want %+v", got, want)
+		}
+		if got := p.ExceededPeriods(); !slices.Equal(got, []int{1}) {
+			t.Errorf("ExceededPeriods() = %v, want [1]", got)
+		}
+	})
+}
+
+func TestBlockedSlots(t *testing.T) {
+	tasks := []Task{
+		{Todo: Todo{Id: "essay", Title: "essay", RequiredTime: 5}, Priority: 2, IsBreakable: true},
+		{Todo: Todo{Id: "slides", Title: "slides", RequiredTime: 3}, Priority: 1},
+	}
+	routines := []Routine{
+		{Todo: Todo{Id: "walk", Title: "walk", RequiredTime: 1}, Position: "start"},
+		{Todo: Todo{Id: "review", Title: "review", RequiredTime: 1}, Position: "block", Block: 2},
+	}
+	// an exam day and two meetings
+	blockedPeriods := []int{1}
+	blockedSlots := []Slot{{Period: 0, Block: 0}, {Period: 2, Block: 3}}
+
+	for _, strategy := range Strategies() {
+		t.Run(strategy.Name(), func(t *testing.T) {
+			p := NewPlanner(units.Hour, units.Day, tasks, routines, 3, 5)
+			p.SetBlockedPeriods(blockedPeriods)
+			p.SetBlockedSlots(blockedSlots)
+			p.SetStrategy(strategy)
+			table, err := p.GenerateTable()
+			if err != nil {
+				t.Fatalf("GenerateTable() error = %v", err)
+			}
+
+			for i, period := range table {
+				for block, cell := range period {
+					blocked := slices.Contains(blockedPeriods, i) || slices.Contains(blockedSlots, Slot{Period: i, Block: block})
+					if blocked && cell.Type != "blocked" {
+						t.Errorf("blocked slot (%d, %d) holds %+v", i, block, cell)
+					}
+				}
+			}
+			if got := p.ExceededPeriods(); !slices.Equal(got, []int{1}) {
+				t.Errorf("ExceededPeriods() = %v, want [1]", got)
+			}
+			for _, i := range []int{0, 2} {
+				if cell := table[i][2]; cell.TodoId != "review" {
+					t.Errorf("slot (%d, 2) holds %+v, want the review", i, cell)
+				}
+			}
+			for _, task := range tasks {
+				if got := len(p.slotsOf(task.Id, false)); got != task.RequiredTime {
+					t.Errorf("%s has %d blocks, want %d", task.Id, got, task.RequiredTime)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package planner
+
+import "math"
+
+// chunkBounds returns the least and most blocks of a task that can be
+// placed in a single period, an unbreakable task is a single chunk.
+// size is the whole task, not what's left of it to place: the least is
+// capped by it only when the whole task is smaller, pinned cells can leave less.
+func chunkBounds(task Task, size int) (int, int) {
+	if !task.IsBreakable {
+		return size, size
+	}
+
+	least, most := min(max(task.MinChunk, 1), max(size, 1)), math.MaxInt
+	if task.MaxChunk > 0 {
+		most = task.MaxChunk
+	}
+	return least, most
+}
+
+// CanSplitIntoChunks reports whether the required time of a task can be
+// split into chunks of min_chunk to max_chunk blocks (0 when unbounded)
+func CanSplitIntoChunks(requiredTime int, minChunk int, maxChunk int) bool {
+	if requiredTime < max(minChunk, 1) {
+		return false
+	}
+	if maxChunk == 0 {
+		return true
+	}
+
+	// k chunks hold from k*minChunk to k*maxChunk blocks
+	chunks := (requiredTime + maxChunk - 1) / maxChunk
+	return chunks*max(minChunk, 1) <= requiredTime
+}
+
+// wholeSize returns the blocks of a task the strategies place in all, the
+// required time of the task they're placing can be what's left of them. The
+// rest of an unbreakable task joins its pinned cells, it's whole with them.
+func (p *Planner) wholeSize(task Task) int {
+	for _, whole := range p.tasks {
+		if whole.Id == task.Id && !whole.IsBreakable {
+			return whole.RequiredTime
+		}
+		if whole.Id == task.Id {
+			return p.remainingTime(whole)
+		}
+	}
+	return task.RequiredTime
+}
+
+// chunkFrequency fits the blocks a task wants in every period to its
+// chunk bounds, without leaving a sliver smaller than a chunk behind
+func (p *Planner) chunkFrequency(task Task, frequency int) int {
+	least, most := chunkBounds(task, p.wholeSize(task))
+	frequency = min(max(frequency, least), most)
+
+	if rest := task.RequiredTime - frequency; rest > 0 && rest < least {
+		if task.RequiredTime <= most {
+			return task.RequiredTime
+		}
+		return task.RequiredTime - least
+	}
+	return frequency
+}
+
+// taskBlocksIn returns the number of cells of a task in a period
+func (p *Planner) taskBlocksIn(id string, index int) int {
+	count := 0
+	for _, cell := range p.table[index] {
+		if cell.Type == "task" && cell.TodoId == id {
+			count++
+		}
+	}
+	return count
+}
+
+// chunkSize returns how many of the wanted blocks of a task can be pushed
+// to a period, keeping the task's blocks in the period and the blocks left
+// after them inside its chunk bounds and the blocks of every task in the
+// period together. It returns 0 when none can.
+func (p *Planner) chunkSize(task Task, index int, wanted int) int {
+	least, most := chunkBounds(task, p.wholeSize(task))
+	placed := p.taskBlocksIn(task.Id, index)
+
+	size := min(wanted, p.capacity(index)-len(p.table[index]), min(most, p.largestRun(index, task.Id))-placed, task.RequiredTime)
+	for ; size > 0 && placed+size >= least; size-- {
+		if rest := task.RequiredTime - size; rest > 0 && rest < least {
+			continue
+		}
+		if p.fitsWhole(task.Id, index, size) {
+			return size
+		}
+	}
+	return 0
+}
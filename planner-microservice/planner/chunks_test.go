@@ -0,0 +1,61 @@
+package planner
+
+import (
+	"math"
+	"testing"
+)
+
+func TestChunkBounds(t *testing.T) {
+	tests := []struct {
+		name      string
+		task      Task
+		size      int
+		wantLeast int
+		wantMost  int
+	}{
+		{
+			name:      "unbounded",
+			task:      Task{Todo: Todo{RequiredTime: 5}, IsBreakable: true},
+			size:      5,
+			wantLeast: 1,
+			wantMost:  math.MaxInt,
+		},
+		{
+			name:      "bounded",
+			task:      Task{Todo: Todo{RequiredTime: 5}, IsBreakable: true, MinChunk: 2, MaxChunk: 3},
+			size:      5,
+			wantLeast: 2,
+			wantMost:  3,
+		},
+		{
+			name:      "remainder keeps the least of the whole task",
+			task:      Task{Todo: Todo{RequiredTime: 1}, IsBreakable: true, MinChunk: 2, MaxChunk: 3},
+			size:      5,
+			wantLeast: 2,
+			wantMost:  3,
+		},
+		{
+			name:      "whole task smaller than a chunk",
+			task:      Task{Todo: Todo{RequiredTime: 1}, IsBreakable: true, MinChunk: 2},
+			size:      1,
+			wantLeast: 1,
+			wantMost:  math.MaxInt,
+		},
+		{
+			name:      "unbreakable",
+			task:      Task{Todo: Todo{RequiredTime: 3}, MinChunk: 2},
+			size:      3,
+			wantLeast: 3,
+			wantMost:  3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			least, most := chunkBounds(tt.task, tt.size)
+			if least != tt.wantLeast || most != tt.wantMost {
+				t.Errorf("chunkBounds() = %d, %d, want %d, %d", least, most, tt.wantLeast, tt.wantMost)
+			}
+		})
+	}
+}
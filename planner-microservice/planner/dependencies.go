@@ -0,0 +1,230 @@
+package planner
+
+import (
+	"planner-microservice/utils"
+	"strings"
+)
+
+// DependencyError lists the prerequisite ids that make the tasks impossible to order
+type DependencyError struct {
+	UnknownIds []string
+	CycleIds   []string
+}
+
+func (e *DependencyError) Error() string {
+	var parts []string
+	if len(e.UnknownIds) > 0 {
+		parts = append(parts, "unknown prerequisite ids: "+strings.Join(e.UnknownIds, ", "))
+	}
+	if len(e.CycleIds) > 0 {
+		parts = append(parts, "dependency cycle between tasks: "+strings.Join(e.CycleIds, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// ValidateDependencies makes sure every prerequisite is a known task and
+// that no task depends on itself, directly or through other tasks
+func (p *Planner) ValidateDependencies() error {
+	known := make(map[string]bool, len(p.tasks))
+	for _, task := range p.tasks {
+		known[task.Id] = true
+	}
+
+	depErr := &DependencyError{}
+	reported := make(map[string]bool)
+	for _, task := range p.tasks {
+		for _, prereq := range task.Prerequisites {
+			if !known[prereq] && !reported[prereq] {
+				depErr.UnknownIds = append(depErr.UnknownIds, prereq)
+				reported[prereq] = true
+			}
+		}
+	}
+
+	for _, task := range p.tasks {
+		if p.dependsOn(task.Id, task.Id, make(map[string]bool)) {
+			depErr.CycleIds = append(depErr.CycleIds, task.Id)
+		}
+	}
+
+	if len(depErr.UnknownIds) > 0 || len(depErr.CycleIds) > 0 {
+		return depErr
+	}
+	return nil
+}
+
+// dependsOn reports whether the task from has to wait for the task to
+func (p *Planner) dependsOn(from string, to string, visited map[string]bool) bool {
+	task, ok := p.findTask(from)
+	if !ok {
+		return false
+	}
+
+	for _, prereq := range task.Prerequisites {
+		if prereq == to {
+			return true
+		}
+		if visited[prereq] {
+			continue
+		}
+		visited[prereq] = true
+		if p.dependsOn(prereq, to, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// dependencyOrder moves every task after its prerequisites,
+// otherwise keeping the order the tasks were given in
+func dependencyOrder(tasks []Task) []Task {
+	listed := make(map[string]bool, len(tasks))
+	for _, task := range tasks {
+		listed[task.Id] = true
+	}
+
+	ready := func(task Task, placed map[string]bool) bool {
+		for _, prereq := range task.Prerequisites {
+			if listed[prereq] && !placed[prereq] {
+				return false
+			}
+		}
+		return true
+	}
+
+	placed := make(map[string]bool, len(tasks))
+	ordered := make([]Task, 0, len(tasks))
+	remaining := append([]Task{}, tasks...)
+
+	for len(remaining) > 0 {
+		next := -1
+		for i, task := range remaining {
+			if ready(task, placed) {
+				next = i
+				break
+			}
+		}
+
+		// cycles are rejected before generating, keep whatever is left as is
+		if next == -1 {
+			return append(ordered, remaining...)
+		}
+
+		ordered = append(ordered, remaining[next])
+		placed[remaining[next].Id] = true
+		remaining = append(remaining[:next], remaining[next+1:]...)
+	}
+
+	return ordered
+}
+
+// deadlineOf returns the latest period index the task can be placed in,
+// a prerequisite has to be done by the deadline of every task waiting for
+// it and by the first period they have pinned cells in
+func (p *Planner) deadlineOf(task Task) (int, bool) {
+	return p.deadlineOfVisited(task, make(map[string]bool))
+}
+
+func (p *Planner) deadlineOfVisited(task Task, visited map[string]bool) (int, bool) {
+	visited[task.Id] = true
+
+	deadline, ok := 0, false
+	if task.HasDeadline() {
+		deadline, ok = *task.Deadline, true
+	}
+
+	for _, dependent := range p.dependentsOf(task.Id) {
+		// the pinned cells of a dependent can't wait for the task
+		if d, has := p.pinnedPeriod(dependent.Id); has && (!ok || d < deadline) {
+			deadline, ok = d, true
+		}
+		if visited[dependent.Id] {
+			continue
+		}
+		if d, has := p.deadlineOfVisited(dependent, visited); has && (!ok || d < deadline) {
+			deadline, ok = d, true
+		}
+	}
+
+	return deadline, ok
+}
+
+func (p *Planner) dependentsOf(id string) []Task {
+	var dependents []Task
+	for _, task := range p.tasks {
+		for _, prereq := range task.Prerequisites {
+			if prereq == id {
+				dependents = append(dependents, task)
+				break
+			}
+		}
+	}
+	return dependents
+}
+
+// leadTime returns how many periods have to be left free after a task
+// for the tasks waiting for it to be placed
+func (p *Planner) leadTime(task Task) int {
+	return p.leadTimeVisited(task, make(map[string]bool))
+}
+
+func (p *Planner) leadTimeVisited(task Task, visited map[string]bool) int {
+	visited[task.Id] = true
+
+	// blocks left for tasks in an average period
+	freeBlocks := 0
+	for i := range p.n_periods {
+		freeBlocks += p.freeBlocks(i)
+	}
+	if p.n_periods > 0 {
+		freeBlocks /= p.n_periods
+	}
+	if freeBlocks < 1 {
+		return 0
+	}
+
+	lead := 0
+	for _, dependent := range p.dependentsOf(task.Id) {
+		if visited[dependent.Id] {
+			continue
+		}
+		periods := utils.DeviseAndCeil(freeBlocks, p.remainingTime(dependent)) + p.leadTimeVisited(dependent, visited)
+		if periods > lead {
+			lead = periods
+		}
+	}
+	return lead
+}
+
+// prerequisitesEnd returns the period holding the last block of the task's prerequisites
+func (p *Planner) prerequisitesEnd(task Task) int {
+	end := 0
+	for _, prereq := range task.Prerequisites {
+		if last := p.lastPeriodOf(prereq); last > end {
+			end = last
+		}
+	}
+	return end
+}
+
+func (p *Planner) firstPeriodOf(taskId string) int {
+	for i, period := range p.table {
+		for _, cell := range period {
+			if cell.Type == "task" && cell.TodoId == taskId {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func (p *Planner) lastPeriodOf(taskId string) int {
+	for i := len(p.table) - 1; i >= 0; i-- {
+		for _, cell := range p.table[i] {
+			if cell.Type == "task" && cell.TodoId == taskId {
+				return i
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,105 @@
+package planner
+
+import (
+	"errors"
+	"planner-microservice/units"
+	"slices"
+	"testing"
+)
+
+func TestValidateDependencies(t *testing.T) {
+	task := func(id string, prerequisites ...string) Task {
+		return Task{Todo: Todo{Id: id, Title: id, RequiredTime: 1}, Priority: 1, Prerequisites: prerequisites}
+	}
+
+	tests := []struct {
+		name        string
+		tasks       []Task
+		wantUnknown []string
+		wantCycle   []string
+	}{
+		{
+			name:  "chain",
+			tasks: []Task{task("read"), task("notes", "read"), task("problems", "read", "notes")},
+		},
+		{
+			name:        "unknown ids",
+			tasks:       []Task{task("read", "book"), task("notes", "book", "lecture")},
+			wantUnknown: []string{"book", "lecture"},
+		},
+		{
+			name:      "cycle",
+			tasks:     []Task{task("read", "problems"), task("notes", "read"), task("problems", "notes"), task("mail")},
+			wantCycle: []string{"read", "notes", "problems"},
+		},
+		{
+			name:      "task waiting for itself",
+			tasks:     []Task{task("read", "read"), task("notes", "read")},
+			wantCycle: []string{"read"},
+		},
+		{
+			name:        "unknown ids and a cycle",
+			tasks:       []Task{task("read", "notes", "book"), task("notes", "read")},
+			wantUnknown: []string{"book"},
+			wantCycle:   []string{"read", "notes"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPlanner(units.Hour, units.Day, tt.tasks, nil, 3, 4)
+			err := p.ValidateDependencies()
+			if tt.wantUnknown == nil && tt.wantCycle == nil {
+				if err != nil {
+					t.Fatalf("ValidateDependencies() error = %v", err)
+				}
+				return
+			}
+
+			var depErr *DependencyError
+			if !errors.As(err, &depErr) {
+				t.Fatalf("ValidateDependencies() error = %v, want a *DependencyError", err)
+			}
+			if !slices.Equal(depErr.UnknownIds, tt.wantUnknown) {
+				t.Errorf("UnknownIds = %q, want %q", depErr.UnknownIds, tt.wantUnknown)
+			}
+			if !slices.Equal(depErr.CycleIds, tt.wantCycle) {
+				t.Errorf("CycleIds = %q, want %q", depErr.CycleIds, tt.wantCycle)
+			}
+		})
+	}
+}
+
+func TestPrerequisitesComeFirst(t *testing.T) {
+	// the tasks waiting for others are the most urgent, they still go after them
+	tasks := []Task{
+		{Todo: Todo{Id: "read", Title: "read", RequiredTime: 5}, Priority: 1, IsBreakable: true},
+		{Todo: Todo{Id: "notes", Title: "notes", RequiredTime: 2}, Priority: 2, Prerequisites: []string{"read"}},
+		{Todo: Todo{Id: "problems", Title: "problems", RequiredTime: 3}, Priority: 3, IsBreakable: true, Prerequisites: []string{"read", "notes"}},
+		{Todo: Todo{Id: "mail", Title: "mail", RequiredTime: 2}, Priority: 1, IsBreakable: true},
+	}
+	routines := []Routine{{Todo: Todo{Id: "walk", Title: "walk", RequiredTime: 1}, Position: "start"}}
+
+	for _, strategy := range Strategies() {
+		t.Run(strategy.Name(), func(t *testing.T) {
+			p := NewPlanner(units.Hour, units.Day, tasks, routines, 4, 5)
+			p.SetStrategy(strategy)
+			p.SetOverflow(OverflowStrict)
+			if _, err := p.GenerateTable(); err != nil {
+				t.Fatalf("GenerateTable() error = %v", err)
+			}
+
+			for _, task := range tasks {
+				slots := p.slotsOf(task.Id, false)
+				if len(slots) != task.RequiredTime {
+					t.Fatalf("%s has %d blocks, want %d", task.Id, len(slots), task.RequiredTime)
+				}
+				for _, id := range task.Prerequisites {
+					prereq := p.slotsOf(id, false)
+					if last := prereq[len(prereq)-1]; !slotBefore(last, slots[0]) {
+						t.Errorf("%s starts at %v, before %s ends at %v", task.Id, slots[0], id, last)
+					}
+				}
+			}
+		})
+	}
+}
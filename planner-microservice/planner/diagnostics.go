@@ -0,0 +1,333 @@
+package planner
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Constraints a plan request can fail
+const (
+	RoutinesExceedBlocks   = "routines_exceed_blocks"
+	TasksExceedCapacity    = "tasks_exceed_capacity"
+	UnbreakableTaskTooLong = "unbreakable_task_too_long"
+	InvalidTaskWindow      = "invalid_task_window"
+	TaskWindowOverloaded   = "task_window_overloaded"
+	ChunkTooLarge          = "chunk_too_large"
+	RoutineTimesExceedPlan = "routine_times_exceed_plan"
+)
+
+// Infeasibility explains why a plan can't be generated
+type Infeasibility struct {
+	Constraint  string
+	Description string
+	TodoIds     []string // todos involved, if any
+	FirstPeriod int      // range of periods involved
+	LastPeriod  int
+	Shortfall   int // blocks missing to satisfy the constraint
+	Fixes       []SuggestedFix
+}
+
+// SuggestedFix is the smallest value of a request field that would satisfy a constraint
+type SuggestedFix struct {
+	Field       string
+	Value       int
+	Description string
+}
+
+func (i Infeasibility) key() string {
+	return i.Constraint + "|" + strings.Join(i.TodoIds, ",")
+}
+
+// Diagnose returns every constraint the plan parameters fail, with the
+// fixes that would make each of them feasible. The plan is valid when
+// nothing is returned.
+func (p *Planner) Diagnose() []Infeasibility {
+	infeasibilities := p.infeasibilities()
+
+	for i := range infeasibilities {
+		key := infeasibilities[i].key()
+		fixed := func() bool {
+			for _, infeasibility := range p.infeasibilities() {
+				if infeasibility.key() == key {
+					return false
+				}
+			}
+			return true
+		}
+
+		if n, ok := p.smallestNPeriods(fixed); ok {
+			infeasibilities[i].Fixes = append(infeasibilities[i].Fixes, SuggestedFix{
+				Field:       "n_periods",
+				Value:       n,
+				Description: fmt.Sprintf("increase the number of periods to %d", n),
+			})
+		}
+		if n, ok := p.smallestNBlocks(fixed); ok {
+			infeasibilities[i].Fixes = append(infeasibilities[i].Fixes, SuggestedFix{
+				Field:       "n_blocks",
+				Value:       n,
+				Description: fmt.Sprintf("increase the number of blocks per period to %d", n),
+			})
+		}
+	}
+
+	return infeasibilities
+}
+
+// smallestNPeriods looks for the least number of periods, more than the
+// current one, that satisfies fixed. Added periods hold n_blocks blocks.
+func (p *Planner) smallestNPeriods(fixed func() bool) (int, bool) {
+	original := p.n_periods
+	defer func() {
+		p.n_periods = original
+	}()
+
+	// every added period gives tasks at least a block, or none at all
+	last := original + p.suggestionRange()
+	if p.n_blocks-routinesTimePerPeriod(p.routines, last) < 1 {
+		return 0, false
+	}
+
+	return smallestValue(original+1, last, func(n int) bool {
+		p.n_periods = n
+		return fixed()
+	})
+}
+
+// smallestNBlocks looks for the least number of blocks per period, more than
+// the current one, that satisfies fixed. It only changes the periods
+// without their own capacity.
+func (p *Planner) smallestNBlocks(fixed func() bool) (int, bool) {
+	original := p.n_blocks
+	defer func() {
+		p.n_blocks = original
+	}()
+
+	if len(p.capacities) >= p.n_periods {
+		return 0, false
+	}
+
+	return smallestValue(original+1, original+p.suggestionRange(), func(n int) bool {
+		p.n_blocks = n
+		return fixed()
+	})
+}
+
+// suggestionRange returns how far a suggested fix can go, far enough
+// to hold every block of the plan in a single period
+func (p *Planner) suggestionRange() int {
+	blocks := totalTasksTime(p.tasks) + routinesTimePerPeriod(p.routines, p.n_periods) + len(p.blocked_slots) + len(p.pinned_cells)
+	return max(blocks, 1)
+}
+
+// smallestValue binary searches the least value in [low, high] that
+// satisfies fixed, assuming every value after it does too
+func smallestValue(low int, high int, fixed func(int) bool) (int, bool) {
+	if !fixed(high) {
+		return 0, false
+	}
+
+	n := low + sort.Search(high-low, func(i int) bool {
+		return fixed(low + i)
+	})
+	return n, true
+}
+
+// infeasibilities checks the plan parameters:
+//   - calculate the total time of tasks that isn't pinned yet
+//   - calculate the blocks left in every period after its pinned cells and routines
+//   - some period has to have a block left
+//   - a routine has to recur in as many periods as the times it's in the plan
+//   - the total time of tasks can't be more than the blocks left
+//   - an unbreakable task, or the least chunk of a breakable one, has to fit
+//     in the blocks left of a single period
+//   - every task's window (earliest start to deadline) must be inside the plan
+//   - tasks whose windows fall inside a range of periods must fit in that range
+//     (prerequisites are due by the deadline of the tasks waiting for them)
+//   - best-effort plans leave out the checks of capacity
+func (p *Planner) infeasibilities() []Infeasibility {
+	var infeasibilities []Infeasibility
+
+	totalTasksTime := 0
+	for _, task := range p.tasks {
+		totalTasksTime += p.remainingTime(task)
+	}
+
+	remainingBlocks := make([]int, p.n_periods)
+	totalRemainingBlocks := 0
+	mostRemainingBlocks := 0
+	for i := range p.n_periods {
+		remainingBlocks[i] = p.freeBlocks(i)
+		totalRemainingBlocks += remainingBlocks[i]
+		mostRemainingBlocks = max(mostRemainingBlocks, remainingBlocks[i])
+	}
+
+	if mostRemainingBlocks < 1 {
+		routinesTime := routinesTimePerPeriod(p.routines, p.n_periods)
+		infeasibilities = append(infeasibilities, Infeasibility{
+			Constraint:  RoutinesExceedBlocks,
+			Description: fmt.Sprintf("routines (%d blocks per period) leave no block for tasks in any period", routinesTime),
+			FirstPeriod: 0,
+			LastPeriod:  p.n_periods - 1,
+			Shortfall:   1,
+		})
+	}
+
+	for _, routine := range p.routines {
+		if periods := p.recurrencePeriods(routine); routine.Times > periods {
+			infeasibilities = append(infeasibilities, Infeasibility{
+				Constraint:  RoutineTimesExceedPlan,
+				Description: fmt.Sprintf("routine %q has to be in %d periods but only %d of the plan can hold it", routine.Title, routine.Times, periods),
+				TodoIds:     []string{routine.Id},
+				FirstPeriod: 0,
+				LastPeriod:  p.n_periods - 1,
+				Shortfall:   (routine.Times - periods) * routine.RequiredTime,
+			})
+		}
+	}
+
+	if totalTasksTime > totalRemainingBlocks {
+		infeasibilities = append(infeasibilities, Infeasibility{
+			Constraint:  TasksExceedCapacity,
+			Description: fmt.Sprintf("tasks need %d blocks but only %d are left after routines", totalTasksTime, totalRemainingBlocks),
+			FirstPeriod: 0,
+			LastPeriod:  p.n_periods - 1,
+			Shortfall:   totalTasksTime - totalRemainingBlocks,
+		})
+	}
+
+	// every range of periods has to fit the tasks whose windows
+	// (earliest start to deadline) fall inside of it
+	windows := make([][2]int, len(p.tasks))
+	valid := make([]bool, len(p.tasks))
+	firsts := map[int]bool{0: true}
+	lasts := map[int]bool{p.n_periods - 1: true}
+	for i, task := range p.tasks {
+		first, last := 0, p.n_periods-1
+		if task.HasEarliestStart() {
+			first = *task.EarliestStart
+		}
+		if deadline, ok := p.deadlineOf(task); ok && deadline < last {
+			last = deadline
+		}
+		if first < 0 || last < first {
+			infeasibilities = append(infeasibilities, Infeasibility{
+				Constraint:  InvalidTaskWindow,
+				Description: fmt.Sprintf("task %q can't start at period %d and be done by period %d", task.Title, first+1, last+1),
+				TodoIds:     []string{task.Id},
+				FirstPeriod: first,
+				LastPeriod:  last,
+				Shortfall:   p.remainingTime(task),
+			})
+			continue
+		}
+
+		windows[i] = [2]int{first, last}
+		valid[i] = true
+		firsts[first] = true
+		lasts[last] = true
+
+		mostBlocksInWindow := 0
+		for j := first; j <= last; j++ {
+			mostBlocksInWindow = max(mostBlocksInWindow, remainingBlocks[j])
+		}
+
+		if task.IsBreakable {
+			leastChunk := min(task.MinChunk, p.remainingTime(task))
+			if shortfall := chunkShortfall(task, p.remainingTime(task), mostBlocksInWindow); leastChunk > 1 && shortfall > 0 {
+				infeasibilities = append(infeasibilities, Infeasibility{
+					Constraint:  ChunkTooLarge,
+					Description: fmt.Sprintf("task %q can't be split into chunks of at least %d blocks with at most %d left in a period", task.Title, leastChunk, mostBlocksInWindow),
+					TodoIds:     []string{task.Id},
+					FirstPeriod: first,
+					LastPeriod:  last,
+					Shortfall:   shortfall,
+				})
+			}
+			continue
+		}
+
+		if p.remainingTime(task) > mostBlocksInWindow {
+			infeasibilities = append(infeasibilities, Infeasibility{
+				Constraint:  UnbreakableTaskTooLong,
+				Description: fmt.Sprintf("unbreakable task %q needs %d blocks but a period has at most %d left", task.Title, p.remainingTime(task), mostBlocksInWindow),
+				TodoIds:     []string{task.Id},
+				FirstPeriod: first,
+				LastPeriod:  last,
+				Shortfall:   p.remainingTime(task) - mostBlocksInWindow,
+			})
+		}
+	}
+
+	for _, first := range sortedKeys(firsts) {
+		for _, last := range sortedKeys(lasts) {
+			// the whole plan is already checked against the total time of tasks
+			if last < first || (first == 0 && last == p.n_periods-1) {
+				continue
+			}
+
+			requiredTime := 0
+			var todoIds []string
+			for i, task := range p.tasks {
+				if valid[i] && windows[i][0] >= first && windows[i][1] <= last {
+					requiredTime += p.remainingTime(task)
+					todoIds = append(todoIds, task.Id)
+				}
+			}
+
+			availableTime := 0
+			for i := first; i <= last; i++ {
+				availableTime += remainingBlocks[i]
+			}
+
+			if requiredTime > availableTime {
+				infeasibilities = append(infeasibilities, Infeasibility{
+					Constraint:  TaskWindowOverloaded,
+					Description: fmt.Sprintf("tasks due from period %d to %d need %d blocks but only %d are left", first+1, last+1, requiredTime, availableTime),
+					TodoIds:     todoIds,
+					FirstPeriod: first,
+					LastPeriod:  last,
+					Shortfall:   requiredTime - availableTime,
+				})
+			}
+		}
+	}
+
+	// best-effort plans place what fits and report the rest
+	if p.overflow == OverflowBestEffort {
+		infeasibilities = slices.DeleteFunc(infeasibilities, func(infeasibility Infeasibility) bool {
+			return slices.Contains(capacityConstraints, infeasibility.Constraint)
+		})
+	}
+
+	return infeasibilities
+}
+
+// chunkShortfall returns how many blocks the fullest period of the window of
+// a breakable task lacks for the rest of it to split into chunks no larger
+// than a period, 0 when it splits already. 6 blocks in chunks of at least 4
+// only fit whole, periods of 4 blocks lack 2.
+func chunkShortfall(task Task, remaining int, mostBlocks int) int {
+	least := min(task.MinChunk, remaining)
+	for blocks := max(mostBlocks, 1); blocks <= remaining; blocks++ {
+		most := blocks
+		if task.MaxChunk > 0 {
+			most = min(most, task.MaxChunk)
+		}
+		if CanSplitIntoChunks(remaining, least, most) {
+			return blocks - mostBlocks
+		}
+	}
+	return max(least-mostBlocks, 0)
+}
+
+func sortedKeys(set map[int]bool) []int {
+	keys := make([]int, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	return keys
+}
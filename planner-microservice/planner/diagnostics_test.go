@@ -0,0 +1,169 @@
+package planner
+
+import (
+	"planner-microservice/units"
+	"slices"
+	"testing"
+)
+
+func TestDiagnose(t *testing.T) {
+	first, late := 0, 3
+	task := func(time int) Task {
+		return Task{Todo: Todo{Id: "essay", Title: "essay", RequiredTime: time}, Priority: 1, IsBreakable: true}
+	}
+
+	type failure struct {
+		constraint string
+		shortfall  int
+		fixes      []SuggestedFix // fields and values only
+	}
+	tests := []struct {
+		name     string
+		tasks    []Task
+		routines []Routine
+		periods  int
+		blocks   int
+		want     []failure
+	}{
+		{
+			name:    "feasible",
+			tasks:   []Task{task(6)},
+			periods: 2,
+			blocks:  3,
+		},
+		{
+			name:     "routines fill the periods",
+			tasks:    []Task{{Todo: Todo{Id: "essay", Title: "essay", RequiredTime: 1}, Priority: 1}},
+			routines: []Routine{{Todo: Todo{Id: "walk", Title: "walk", RequiredTime: 2}}},
+			periods:  2,
+			blocks:   2,
+			want: []failure{
+				{constraint: RoutinesExceedBlocks, shortfall: 1, fixes: []SuggestedFix{{Field: "n_blocks", Value: 3}}},
+				{constraint: TasksExceedCapacity, shortfall: 1, fixes: []SuggestedFix{{Field: "n_blocks", Value: 3}}},
+				{constraint: UnbreakableTaskTooLong, shortfall: 1, fixes: []SuggestedFix{{Field: "n_blocks", Value: 3}}},
+			},
+		},
+		{
+			name:    "tasks exceed the plan",
+			tasks:   []Task{task(7)},
+			periods: 2,
+			blocks:  3,
+			want: []failure{
+				{constraint: TasksExceedCapacity, shortfall: 1, fixes: []SuggestedFix{{Field: "n_periods", Value: 3}, {Field: "n_blocks", Value: 4}}},
+			},
+		},
+		{
+			name:    "unbreakable task longer than a period",
+			tasks:   []Task{{Todo: Todo{Id: "essay", Title: "essay", RequiredTime: 4}, Priority: 1}},
+			periods: 3,
+			blocks:  3,
+			want: []failure{
+				{constraint: UnbreakableTaskTooLong, shortfall: 1, fixes: []SuggestedFix{{Field: "n_blocks", Value: 4}}},
+			},
+		},
+		{
+			name:    "chunk longer than a period",
+			tasks:   []Task{{Todo: Todo{Id: "essay", Title: "essay", RequiredTime: 8}, Priority: 1, IsBreakable: true, MinChunk: 4}},
+			periods: 3,
+			blocks:  3,
+			want: []failure{
+				{constraint: ChunkTooLarge, shortfall: 1, fixes: []SuggestedFix{{Field: "n_blocks", Value: 4}}},
+			},
+		},
+		{
+			// 6 blocks don't split into chunks of at least 4, the task only fits whole
+			name:    "task too short to split",
+			tasks:   []Task{{Todo: Todo{Id: "essay", Title: "essay", RequiredTime: 6}, Priority: 1, IsBreakable: true, MinChunk: 4}},
+			periods: 3,
+			blocks:  4,
+			want: []failure{
+				{constraint: ChunkTooLarge, shortfall: 2, fixes: []SuggestedFix{{Field: "n_blocks", Value: 6}}},
+			},
+		},
+		{
+			name:    "earliest start past the plan",
+			tasks:   []Task{{Todo: Todo{Id: "essay", Title: "essay", RequiredTime: 2}, Priority: 1, IsBreakable: true, EarliestStart: &late}},
+			periods: 2,
+			blocks:  3,
+			want: []failure{
+				{constraint: InvalidTaskWindow, shortfall: 2, fixes: []SuggestedFix{{Field: "n_periods", Value: 4}}},
+			},
+		},
+		{
+			name:    "deadline too close",
+			tasks:   []Task{{Todo: Todo{Id: "essay", Title: "essay", RequiredTime: 4}, Priority: 1, IsBreakable: true, Deadline: &first}},
+			periods: 3,
+			blocks:  3,
+			want: []failure{
+				{constraint: TaskWindowOverloaded, shortfall: 1, fixes: []SuggestedFix{{Field: "n_blocks", Value: 4}}},
+			},
+		},
+		{
+			name:     "routine recurring past the plan",
+			tasks:    []Task{task(1)},
+			routines: []Routine{{Todo: Todo{Id: "walk", Title: "walk", RequiredTime: 1}, Every: 2, Times: 3}},
+			periods:  4,
+			blocks:   3,
+			want: []failure{
+				{constraint: RoutineTimesExceedPlan, shortfall: 1, fixes: []SuggestedFix{{Field: "n_periods", Value: 5}}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planner := func(periods int, blocks int) *Planner {
+				p := NewPlanner(units.Hour, units.Day, tt.tasks, tt.routines, periods, blocks)
+				p.SetOverflow(OverflowStrict)
+				return p
+			}
+
+			infeasibilities := planner(tt.periods, tt.blocks).Diagnose()
+			var got []failure
+			for _, infeasibility := range infeasibilities {
+				var fixes []SuggestedFix
+				for _, fix := range infeasibility.Fixes {
+					fixes = append(fixes, SuggestedFix{Field: fix.Field, Value: fix.Value})
+				}
+				got = append(got, failure{constraint: infeasibility.Constraint, shortfall: infeasibility.Shortfall, fixes: fixes})
+			}
+			if !slices.EqualFunc(got, tt.want, func(a failure, b failure) bool {
+				return a.constraint == b.constraint && a.shortfall == b.shortfall && slices.Equal(a.fixes, b.fixes)
+			}) {
+				t.Fatalf("Diagnose() = %+v, want %+v", got, tt.want)
+			}
+
+			// a fix is the smallest value that clears its constraint
+			for _, infeasibility := range infeasibilities {
+				for _, fix := range infeasibility.Fixes {
+					fixed := func(value int) (*Planner, bool) {
+						periods, blocks := tt.periods, tt.blocks
+						if fix.Field == "n_periods" {
+							periods = value
+						} else {
+							blocks = value
+						}
+						p := planner(periods, blocks)
+						return p, !slices.ContainsFunc(p.Diagnose(), func(other Infeasibility) bool {
+							return other.key() == infeasibility.key()
+						})
+					}
+
+					p, ok := fixed(fix.Value)
+					if !ok {
+						t.Errorf("%s = %d doesn't clear %s", fix.Field, fix.Value, infeasibility.Constraint)
+					}
+					if _, ok := fixed(fix.Value - 1); ok {
+						t.Errorf("%s = %d already clears %s", fix.Field, fix.Value-1, infeasibility.Constraint)
+					}
+
+					// nothing else failing, the fixed request gives a plan
+					if len(p.Diagnose()) == 0 {
+						if _, err := p.GenerateTable(); err != nil {
+							t.Errorf("%s = %d: GenerateTable() error = %v", fix.Field, fix.Value, err)
+						}
+					}
+				}
+			}
+		})
+	}
+}
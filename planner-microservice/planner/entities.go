@@ -1,5 +1,7 @@
 package planner
 
+import "fmt"
+
 type Todo struct {
 	Id           string
 	Title        string
@@ -13,9 +15,9 @@ func NewTodo(
 	title string,
 	description string,
 	required_time int,
-	_type string) *Todo {
+	_type string) (*Todo, error) {
 	if _type != "task" && _type != "routine" {
-		panic("Invalid type: must be either 'task' or 'routine'")
+		return nil, fmt.Errorf("invalid type %q: must be either 'task' or 'routine'", _type)
 	}
 
 	return &Todo{
@@ -24,13 +26,18 @@ func NewTodo(
 		Description:  description,
 		RequiredTime: required_time,
 		Type:         _type,
-	}
+	}, nil
 }
 
 type Task struct {
 	Todo
-	Priority    int
-	IsBreakable bool
+	Priority      int
+	IsBreakable   bool
+	Deadline      *int     // latest period index, nil when the task has no deadline
+	Prerequisites []string // ids of the tasks that have to be done before this one
+	EarliestStart *int     // first period index, nil when the task can start right away
+	MinChunk      int      // least blocks of a breakable task in a period, 0 when unbounded
+	MaxChunk      int      // most blocks of a breakable task in a period, 0 when unbounded
 }
 
 func NewTask(
@@ -39,40 +46,70 @@ func NewTask(
 	description string,
 	required_time int,
 	priority int,
-	is_breakable bool) *Task {
+	is_breakable bool) (*Task, error) {
+	todo, err := NewTodo(id, title, description, required_time, "task")
+	if err != nil {
+		return nil, err
+	}
+
 	return &Task{
-		Todo:        *NewTodo(id, title, description, required_time, "task"),
+		Todo:        *todo,
 		Priority:    priority,
 		IsBreakable: is_breakable,
-	}
+	}, nil
+}
+
+func (t *Task) HasDeadline() bool {
+	return t.Deadline != nil
+}
+
+func (t *Task) HasEarliestStart() bool {
+	return t.EarliestStart != nil
 }
 
 type Routine struct {
 	Todo
+	Position string // "start" (default), "end" or "block" of every period
+	Block    int    // block index of the routine's first cell when Position is "block"
+	Every    int    // recurs every this many periods, 0 or 1 for every period
+	Offsets  []int  // periods of every cycle of Every periods the routine is in, the first one when empty
+	Times    int    // number of periods of the plan the routine is in, 0 for all it recurs in
 }
 
 func NewRoutine(
 	id string,
 	title string,
 	description string,
-	required_time int) *Routine {
-	return &Routine{
-		Todo: *NewTodo(id, title, description, required_time, "routine"),
+	required_time int) (*Routine, error) {
+	todo, err := NewTodo(id, title, description, required_time, "routine")
+	if err != nil {
+		return nil, err
 	}
+
+	return &Routine{
+		Todo: *todo,
+	}, nil
 }
 
 type TableCell struct {
 	Type   string
 	TodoId string
+	Pinned bool
 }
 
-func NewTableCell(_type string, todo_id string) *TableCell {
-	if _type != "task" && _type != "routine" {
-		panic("Invalid type: must be either 'task' or 'routine'")
+func NewTableCell(_type string, todo_id string) (*TableCell, error) {
+	if _type != "task" && _type != "routine" && _type != "blocked" && _type != "free" {
+		return nil, fmt.Errorf("invalid type %q: must be either 'task', 'routine', 'blocked' or 'free'", _type)
 	}
 
 	return &TableCell{
 		Type:   _type,
 		TodoId: todo_id,
-	}
+	}, nil
+}
+
+// Slot points at a single block of a period
+type Slot struct {
+	Period int
+	Block  int
 }
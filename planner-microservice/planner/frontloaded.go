@@ -0,0 +1,62 @@
+package planner
+
+import "sort"
+
+// frontLoaded places the most urgent tasks first and every task as early as
+// it can go, filling the first periods of the plan and leaving the last ones free
+type frontLoaded struct{}
+
+func (frontLoaded) Name() string {
+	return "front-loaded"
+}
+
+func (frontLoaded) Description() string {
+	return "Finishes the highest priority work as early as possible, filling the first periods first"
+}
+
+func (f frontLoaded) placeTasks(p *Planner, tasks []Task) error {
+	// highest priority first, then earliest deadline
+	order := priorityOrder(p, tasks)
+	sort.SliceStable(order, func(i, j int) bool {
+		if order[i].Priority != order[j].Priority {
+			return order[i].Priority > order[j].Priority
+		}
+		_, iEnd := p.window(order[i])
+		_, jEnd := p.window(order[j])
+		return iEnd < jEnd
+	})
+
+	for _, task := range dependencyOrder(order) {
+		if err := f.placeTask(p, task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (frontLoaded) placeTask(p *Planner, task Task) error {
+	_, most := chunkBounds(task, p.wholeSize(task))
+	start, end := p.taskWindow(task)
+
+	for i := start; i <= end && i < len(p.table) && task.RequiredTime > 0; i++ {
+		if !task.IsBreakable {
+			if p.isPlacesAvailable(task.RequiredTime, i) && p.fitsWhole(task.Id, i, task.RequiredTime) {
+				p.pushBlocks(task, i, task.RequiredTime)
+				task.RequiredTime = 0
+			}
+			continue
+		}
+
+		blocks := p.chunkSize(task, i, most)
+		p.pushBlocks(task, i, blocks)
+		task.RequiredTime -= blocks
+	}
+
+	// the window is full, the greedy strategy makes room for what's left
+	if task.RequiredTime > 0 {
+		if err := p.pushLeftover(task); err != nil {
+			return p.leaveUnscheduled(task, err)
+		}
+	}
+	return nil
+}
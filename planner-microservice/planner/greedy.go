@@ -0,0 +1,208 @@
+package planner
+
+import (
+	"fmt"
+	"planner-microservice/utils"
+	"sort"
+)
+
+// greedy spreads every task over its window in priority order, making room
+// in the fullest periods or appending periods when the window is full
+type greedy struct{}
+
+func (greedy) Name() string {
+	return "greedy"
+}
+
+func (greedy) Description() string {
+	return "Spreads every task evenly over the periods it can go in, in priority order"
+}
+
+func (greedy) placeTasks(p *Planner, tasks []Task) error {
+	// Prerequisites are always placed before the tasks waiting for them
+	for _, task := range dependencyOrder(priorityOrder(p, tasks)) {
+		if err := p.pushTask(task); err != nil {
+			if err = p.leaveUnscheduled(task, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// priorityOrder puts the tasks with a deadline, an earliest start or pinned
+// cells they can't be broken up from first, so the tasks that can go
+// anywhere fill the room around them, then the other tasks from the highest
+// priority to the lowest. Priorities are weights of any size: the order only
+// decides which task gets the pick of the room, how early a task is placed
+// is weighed by pushTask and the solver.
+func priorityOrder(p *Planner, tasks []Task) []Task {
+	var windowedTasks, otherTasks []Task
+	for _, task := range tasks {
+		_, pinned := p.pinnedPeriod(task.Id)
+		if _, ok := p.deadlineOf(task); ok || task.HasEarliestStart() || (pinned && !task.IsBreakable) {
+			windowedTasks = append(windowedTasks, task)
+			continue
+		}
+		otherTasks = append(otherTasks, task)
+	}
+
+	// earliest deadline first, then latest start first
+	sort.SliceStable(windowedTasks, func(i, j int) bool {
+		aStart, aEnd := p.window(windowedTasks[i])
+		bStart, bEnd := p.window(windowedTasks[j])
+		if aEnd != bEnd {
+			return aEnd < bEnd
+		}
+		if aStart != bStart {
+			return aStart > bStart
+		}
+		return windowedTasks[i].Priority > windowedTasks[j].Priority
+	})
+
+	sort.SliceStable(otherTasks, func(i, j int) bool {
+		if otherTasks[i].Priority != otherTasks[j].Priority {
+			return otherTasks[i].Priority > otherTasks[j].Priority
+		}
+		return compareTasks(otherTasks[i], otherTasks[j])
+	})
+
+	order := append(windowedTasks, otherTasks...)
+
+	// when not everything fits, the most urgent tasks take the room first
+	if p.overflow == OverflowBestEffort {
+		sort.SliceStable(order, func(i, j int) bool {
+			return order[i].Priority > order[j].Priority
+		})
+	}
+	return order
+}
+
+// weightedSpan returns how many of the periods of a window the blocks of a
+// task are spread over: all of them for the tasks of the lowest priority and
+// fewer, the first ones, the higher the priority, so a task weighing 7 next to
+// tasks weighing 1 takes a seventh of its window
+func (p *Planner) weightedSpan(task Task, periods int) int {
+	lowest := max(task.Priority, 1)
+	for _, other := range p.tasks {
+		lowest = min(lowest, max(other.Priority, 1))
+	}
+	return max(utils.DeviseAndCeil(max(task.Priority, 1), periods*lowest), 1)
+}
+
+// pushTask spreads the blocks of a task over the first periods of its window,
+// fewer of them the higher its priority, making room when they're full
+func (p *Planner) pushTask(task Task) error {
+	start, end := p.window(task)
+
+	// Define default taskBlocksFrequency & handle undivisible
+	var taskBlocksFrequency int
+	if !task.IsBreakable {
+		taskBlocksFrequency = task.RequiredTime
+	} else {
+		taskBlocksFrequency = p.chunkFrequency(task, utils.DeviseAndCeil(task.RequiredTime, end-start+1))
+
+		// heavier tasks fill the first periods of their window
+		if span := p.weightedSpan(task, end-start+1); span < end-start+1 {
+			taskBlocksFrequency = p.chunkFrequency(task, utils.DeviseAndCeil(span, task.RequiredTime))
+		}
+	}
+
+	changed := false
+	var remainingPeriods int
+
+	pushToResultArray := func(index int, blocks int) {
+		for x := 0; x < blocks; x++ {
+			if task.RequiredTime == 0 {
+				break
+			}
+			p.table[index] = append(p.table[index], TableCell{
+				Type:   "task",
+				TodoId: task.Id,
+			})
+			task.RequiredTime--
+		}
+	}
+
+	var pusher func() error
+	pusher = func() error {
+		// the window grows when a period is appended to the plan
+		start, end = p.window(task)
+		requiredTimeBefore := task.RequiredTime
+
+		// spread prerequisites so the tasks waiting for them still fit after
+		if lead := p.leadTime(task); lead > 0 {
+			end = max(end-lead, start)
+		}
+
+		for i := start; i <= end; i++ {
+			if p.table[i] == nil {
+				p.table[i] = make([]TableCell, 0)
+			}
+
+			if len(p.table[i]) >= p.capacity(i) {
+				continue
+			}
+
+			if !task.IsBreakable {
+				if p.isPlacesAvailable(taskBlocksFrequency, i) && p.fitsWhole(task.Id, i, taskBlocksFrequency) {
+					pushToResultArray(i, taskBlocksFrequency)
+				}
+				continue
+			}
+
+			if changed {
+				remainingPeriods = end - i
+				taskBlocksFrequency = p.chunkFrequency(task, utils.DeviseAndCeil(task.RequiredTime, remainingPeriods))
+				changed = false
+			}
+
+			if !p.isPlacesAvailable(taskBlocksFrequency, i) {
+				for !p.isPlacesAvailable(taskBlocksFrequency, i) {
+					taskBlocksFrequency--
+					changed = true
+				}
+			}
+
+			// a period without room for a whole chunk is skipped
+			if blocks := p.chunkSize(task, i, taskBlocksFrequency); blocks > 0 {
+				pushToResultArray(i, blocks)
+				if task.RequiredTime == 0 {
+					return nil
+				}
+			}
+		}
+
+		if task.RequiredTime > 0 {
+			if task.IsBreakable && task.RequiredTime < requiredTimeBefore {
+				taskBlocksFrequency = p.chunkFrequency(task, utils.DeviseAndCeil(task.RequiredTime, end-start+1))
+				return pusher()
+			}
+
+			// no room left in the task's window, make some
+			if task.IsBreakable {
+				taskBlocksFrequency = p.chunkFrequency(task, utils.DeviseAndCeil(task.RequiredTime, end-start+1))
+			}
+			avIndex, err := p.generateAvailability(task, taskBlocksFrequency)
+			if err != nil {
+				return err
+			}
+
+			blocks := p.chunkSize(task, avIndex, taskBlocksFrequency)
+			if blocks == 0 {
+				size := p.wholeSize(task)
+				least, most := chunkBounds(task, size)
+				return fmt.Errorf("task %q can't be split into chunks of %d to %d blocks", task.Title, least, min(most, size))
+			}
+			pushToResultArray(avIndex, blocks)
+
+			if task.RequiredTime > 0 {
+				return pusher()
+			}
+		}
+
+		return nil
+	}
+
+	return pusher()
+}
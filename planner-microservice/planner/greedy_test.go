@@ -0,0 +1,79 @@
+package planner
+
+import (
+	"errors"
+	"planner-microservice/units"
+	"testing"
+)
+
+func TestWeightedSpan(t *testing.T) {
+	p := NewPlanner(units.Hour, units.Day, []Task{
+		{Todo: Todo{Id: "hi"}, Priority: 7},
+		{Todo: Todo{Id: "mid"}, Priority: 2},
+		{Todo: Todo{Id: "lo"}, Priority: 1},
+	}, nil, 4, 8)
+
+	tests := []struct {
+		priority int
+		periods  int
+		want     int
+	}{
+		{priority: 1, periods: 4, want: 4},
+		{priority: 2, periods: 4, want: 2},
+		{priority: 7, periods: 4, want: 1},
+		{priority: 2, periods: 5, want: 3},
+		{priority: 0, periods: 4, want: 4},
+	}
+	for _, tt := range tests {
+		if got := p.weightedSpan(Task{Priority: tt.priority}, tt.periods); got != tt.want {
+			t.Errorf("weightedSpan(priority %d, %d periods) = %d, want %d", tt.priority, tt.periods, got, tt.want)
+		}
+	}
+}
+
+// TestGreedyWeighsPriorities checks that a heavier task takes more of the
+// first periods than a lighter one when there's room for both
+func TestGreedyWeighsPriorities(t *testing.T) {
+	p := NewPlanner(units.Hour, units.Day, []Task{
+		{Todo: Todo{Id: "hi", Title: "hi", RequiredTime: 4}, Priority: 7, IsBreakable: true},
+		{Todo: Todo{Id: "lo", Title: "lo", RequiredTime: 4}, Priority: 1, IsBreakable: true},
+	}, nil, 4, 8)
+
+	if _, err := p.GenerateTable(); err != nil {
+		t.Fatal(err)
+	}
+	if hi, lo := p.taskBlocksIn("hi", 0), p.taskBlocksIn("lo", 0); hi <= lo {
+		t.Errorf("the first period holds %d blocks of hi and %d of lo, want more of hi", hi, lo)
+	}
+}
+
+// TestGreedyFailsWhenGrowing checks that a plan that may grow fails instead
+// of leaving a task out
+func TestGreedyFailsWhenGrowing(t *testing.T) {
+	deadline := 0
+	tasks := []Task{
+		{Todo: Todo{Id: "a", Title: "a", RequiredTime: 3}, Priority: 1, Deadline: &deadline},
+		{Todo: Todo{Id: "b", Title: "b", RequiredTime: 3}, Priority: 1, Deadline: &deadline},
+	}
+
+	p := NewPlanner(units.Hour, units.Day, tasks, nil, 2, 4)
+	if _, err := p.GenerateTable(); err == nil {
+		t.Error("GenerateTable() succeeded, want an error for the task past its deadline")
+	}
+
+	p = NewPlanner(units.Hour, units.Day, tasks, nil, 2, 4)
+	p.SetOverflow(OverflowBestEffort)
+	if _, err := p.GenerateTable(); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Unscheduled()) != 1 {
+		t.Errorf("unscheduled %+v, want one task", p.Unscheduled())
+	}
+
+	var capacityErr *CapacityError
+	p = NewPlanner(units.Hour, units.Day, tasks, nil, 2, 4)
+	p.SetOverflow(OverflowStrict)
+	if _, err := p.GenerateTable(); !errors.As(err, &capacityErr) {
+		t.Errorf("GenerateTable() error = %v, want a CapacityError", err)
+	}
+}
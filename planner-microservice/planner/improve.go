@@ -0,0 +1,344 @@
+package planner
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"time"
+)
+
+// DefaultIterations is the number of moves the improvement pass tries when the plan doesn't say
+const DefaultIterations = 2000
+
+// Improvement bounds the local search run on a generated table
+type Improvement struct {
+	Seed       int64         // the same seed and table give the same result
+	Iterations int           // moves to try, DefaultIterations when 0
+	TimeLimit  time.Duration // no limit when 0, a search stopped by it may differ between runs
+}
+
+// SetImprovement runs the improvement pass at the end of GenerateTable, nil turns it off
+func (p *Planner) SetImprovement(improvement *Improvement) {
+	p.improvement = improvement
+}
+
+// Improve moves the blocks of the tasks of a table between periods by
+// simulated annealing to leave fewer fragments and an even load. Moves keep
+// every constraint of the tasks: capacities, windows, prerequisites, chunk
+// bounds and unbreakable tasks. Pinned cells and routines stay in place.
+func (p *Planner) Improve(table [][]TableCell, improvement Improvement) [][]TableCell {
+	s := newLocalSearch(p, table)
+	if len(s.tasks) == 0 {
+		return table
+	}
+
+	iterations := improvement.Iterations
+	if iterations <= 0 {
+		iterations = DefaultIterations
+	}
+	deadline := p.deadline
+	if improvement.TimeLimit > 0 {
+		deadline = p.searchDeadline(improvement.TimeLimit)
+	}
+
+	random := rand.New(rand.NewSource(improvement.Seed))
+	current := s.cost()
+	s.initial = current
+	best, bestCost := s.snapshot(), current
+	for iteration := range iterations {
+		if !deadline.IsZero() && iteration%64 == 0 && time.Now().After(deadline) {
+			break
+		}
+
+		undo, ok := s.move(random)
+		if !ok {
+			continue
+		}
+
+		// worse moves are taken less and less often as the search cools down
+		temperature := 0.1 * math.Pow(0.001, float64(iteration)/float64(iterations))
+		cost := s.cost()
+		if cost <= current || random.Float64() < math.Exp((current-cost)/temperature) {
+			current = cost
+			if cost < bestCost {
+				best, bestCost = s.snapshot(), cost
+			}
+			continue
+		}
+		undo()
+	}
+
+	if bestCost >= s.initial {
+		return table
+	}
+
+	// rebuild the table around the cells that don't move
+	p.table = make([][]TableCell, len(table))
+	for i := range table {
+		p.table[i] = append([]TableCell{}, s.fixed[i]...)
+		for k, task := range s.tasks {
+			p.pushBlocks(task, i, best[k][i])
+		}
+	}
+	p.layoutTable()
+	return p.table
+}
+
+// localSearch holds the blocks of the tasks of a table, alloc[k][i] is the
+// number of movable blocks of the k-th task in the i-th period
+type localSearch struct {
+	p      *Planner
+	tasks  []Task
+	index  map[string]int
+	fixed  [][]TableCell // cells that don't move: pinned cells and routines
+	pinned [][]int       // pinned blocks of every task in every period
+	alloc  [][]int
+	used   []int
+	runs   []int // largest free run of every period
+	start  []int
+	end    []int
+
+	initial float64 // cost of the table before any move
+}
+
+func newLocalSearch(p *Planner, table [][]TableCell) *localSearch {
+	n := len(table)
+	s := &localSearch{
+		p:     p,
+		index: make(map[string]int),
+		fixed: make([][]TableCell, n),
+		used:  make([]int, n),
+		runs:  make([]int, n),
+	}
+	for i := range n {
+		s.runs[i] = p.largestRun(i, "")
+	}
+
+	for _, task := range p.tasks {
+		start, end := 0, n-1
+		if task.HasEarliestStart() {
+			start = *task.EarliestStart
+		}
+		if deadline, ok := p.deadlineOf(task); ok {
+			end = min(deadline, end)
+		}
+		if period, ok := p.pinnedPeriod(task.Id); ok && !task.IsBreakable {
+			start, end = period, period
+		}
+		s.index[task.Id] = len(s.tasks)
+		s.tasks = append(s.tasks, task)
+		s.start = append(s.start, start)
+		s.end = append(s.end, end)
+		s.pinned = append(s.pinned, make([]int, n))
+		s.alloc = append(s.alloc, make([]int, n))
+	}
+
+	for i, period := range table {
+		for _, cell := range period {
+			if cell.Type != "task" && cell.Type != "routine" {
+				continue
+			}
+			s.used[i]++
+
+			k, ok := s.index[cell.TodoId]
+			switch {
+			case cell.Type == "task" && ok && !cell.Pinned:
+				s.alloc[k][i]++
+			case cell.Type == "task" && ok:
+				s.pinned[k][i]++
+				s.fixed[i] = append(s.fixed[i], cell)
+			default:
+				s.fixed[i] = append(s.fixed[i], cell)
+			}
+		}
+	}
+	return s
+}
+
+func (s *localSearch) snapshot() [][]int {
+	alloc := make([][]int, len(s.alloc))
+	for k := range s.alloc {
+		alloc[k] = append([]int{}, s.alloc[k]...)
+	}
+	return alloc
+}
+
+// cost is lower for fewer fragments and an even load, like the balance and
+// compactness parts of the score of Evaluate
+func (s *localSearch) cost() float64 {
+	var utilisations []float64
+	for i, used := range s.used {
+		if capacity := max(s.p.capacity(i), used); capacity > 0 {
+			utilisations = append(utilisations, float64(used)/float64(capacity))
+		}
+	}
+
+	tasks, fragments := 0, 0
+	for k := range s.tasks {
+		placed := false
+		for i := range s.alloc[k] {
+			if s.alloc[k][i]+s.pinned[k][i] > 0 {
+				fragments++
+				placed = true
+			}
+		}
+		if placed {
+			tasks++
+		}
+	}
+
+	balance := 1 - min(2*math.Sqrt(variance(utilisations)), 1)
+	compactness := 1.0
+	if fragments > 0 {
+		compactness = float64(tasks) / float64(fragments)
+	}
+	return -(balance + compactness)
+}
+
+// move applies a random move that keeps every constraint, it returns how to undo it
+func (s *localSearch) move(random *rand.Rand) (func(), bool) {
+	k := random.Intn(len(s.tasks))
+	from := s.periodOf(k, random)
+	if from == -1 {
+		return nil, false
+	}
+	to := random.Intn(len(s.alloc[k]))
+	if to == from {
+		return nil, false
+	}
+
+	var moves [][3]int // task, from and to of every shift
+	var blocks []int
+	switch random.Intn(4) {
+	case 0:
+		// the whole chunk joins the task's blocks in another period
+		moves, blocks = [][3]int{{k, from, to}}, []int{s.alloc[k][from]}
+	case 1:
+		// a single block goes to another period
+		if !s.tasks[k].IsBreakable {
+			return nil, false
+		}
+		moves, blocks = [][3]int{{k, from, to}}, []int{1}
+	case 2:
+		// the chunk is swapped with the chunk of another task
+		other := random.Intn(len(s.tasks))
+		if other == k || s.alloc[other][to] == 0 || s.alloc[k][to] > 0 || s.alloc[other][from] > 0 {
+			return nil, false
+		}
+		moves, blocks = [][3]int{{k, from, to}, {other, to, from}}, []int{s.alloc[k][from], s.alloc[other][to]}
+	default:
+		// a block is swapped with a block of another task, for full periods
+		other := random.Intn(len(s.tasks))
+		if other == k || s.alloc[other][to] == 0 {
+			return nil, false
+		}
+		moves, blocks = [][3]int{{k, from, to}, {other, to, from}}, []int{1, 1}
+	}
+
+	for j, m := range moves {
+		s.shift(m[0], m[1], m[2], blocks[j])
+	}
+	undo := func() {
+		for j := len(moves) - 1; j >= 0; j-- {
+			s.shift(moves[j][0], moves[j][2], moves[j][1], blocks[j])
+		}
+	}
+
+	for _, m := range moves {
+		if !s.valid(m[0]) || s.used[m[2]] > max(s.p.capacity(m[2]), 0) || !s.keepsTogether(m[1]) || !s.keepsTogether(m[2]) {
+			undo()
+			return nil, false
+		}
+	}
+	return undo, true
+}
+
+// keepsTogether reports whether the i-th period keeps the blocks of every task together
+func (s *localSearch) keepsTogether(i int) bool {
+	period := slices.Clone(s.fixed[i])
+	for k, task := range s.tasks {
+		for range s.alloc[k][i] {
+			period = append(period, TableCell{
+				Type:   "task",
+				TodoId: task.Id,
+			})
+		}
+	}
+	return s.p.keepsTogether(i, period)
+}
+
+// periodOf returns a random period holding movable blocks of the k-th task, -1 when there's none
+func (s *localSearch) periodOf(k int, random *rand.Rand) int {
+	var periods []int
+	for i, blocks := range s.alloc[k] {
+		if blocks > 0 {
+			periods = append(periods, i)
+		}
+	}
+	if len(periods) == 0 {
+		return -1
+	}
+	return periods[random.Intn(len(periods))]
+}
+
+func (s *localSearch) shift(k int, from int, to int, blocks int) {
+	s.alloc[k][from] -= blocks
+	s.alloc[k][to] += blocks
+	s.used[from] -= blocks
+	s.used[to] += blocks
+}
+
+// valid reports whether the blocks of the k-th task keep its window, its
+// chunk bounds and its order with its prerequisites and dependents
+func (s *localSearch) valid(k int) bool {
+	task := s.tasks[k]
+	least, most := chunkBounds(task, task.RequiredTime)
+
+	chunks := 0
+	for i, blocks := range s.alloc[k] {
+		if blocks == 0 {
+			continue
+		}
+		chunks++
+		if i < s.start[k] || i > s.end[k] {
+			return false
+		}
+		run := s.runs[i]
+		if s.pinned[k][i] > 0 {
+			run = s.p.largestRun(i, task.Id)
+		}
+		if total := blocks + s.pinned[k][i]; total < least || total > min(most, run) {
+			return false
+		}
+	}
+	if !task.IsBreakable && chunks > 1 {
+		return false
+	}
+
+	first, last := s.span(k)
+	for _, id := range task.Prerequisites {
+		if j, ok := s.index[id]; ok {
+			if _, prereqLast := s.span(j); prereqLast > first {
+				return false
+			}
+		}
+	}
+	for _, dependent := range s.p.dependentsOf(task.Id) {
+		if dependentFirst, _ := s.span(s.index[dependent.Id]); dependentFirst < last {
+			return false
+		}
+	}
+	return true
+}
+
+// span returns the first and last periods holding blocks of the k-th task
+func (s *localSearch) span(k int) (int, int) {
+	first, last := math.MaxInt, -1
+	for i := range s.alloc[k] {
+		if s.alloc[k][i]+s.pinned[k][i] > 0 {
+			first = min(first, i)
+			last = max(last, i)
+		}
+	}
+	return first, last
+}
@@ -0,0 +1,141 @@
+package planner
+
+import (
+	"fmt"
+	"math/rand"
+	"planner-microservice/units"
+	"slices"
+	"testing"
+)
+
+// randomPlanner returns a planner of a few tasks with chunk bounds, windows
+// and prerequisites, and a routine
+func randomPlanner(random *rand.Rand) *Planner {
+	periods, blocks := 3+random.Intn(3), 5+random.Intn(4)
+	var tasks []Task
+	for i := range 3 + random.Intn(4) {
+		id := fmt.Sprint("task", i)
+		task := Task{Todo: Todo{Id: id, Title: id, RequiredTime: 1 + random.Intn(5)}, Priority: 1 + random.Intn(3)}
+		if random.Intn(3) > 0 {
+			task.IsBreakable = true
+			task.MinChunk = random.Intn(3)
+			task.MaxChunk = random.Intn(2) * (task.MinChunk + 2)
+		}
+		if random.Intn(4) == 0 {
+			deadline := 1 + random.Intn(periods-1)
+			task.Deadline = &deadline
+		}
+		if random.Intn(4) == 0 {
+			start := random.Intn(2)
+			task.EarliestStart = &start
+		}
+		if i > 0 && random.Intn(3) == 0 {
+			task.Prerequisites = []string{fmt.Sprint("task", random.Intn(i))}
+		}
+		tasks = append(tasks, task)
+	}
+	routines := []Routine{{Todo: Todo{Id: "walk", Title: "walk", RequiredTime: 1}, Position: "start", Every: random.Intn(3)}}
+
+	p := NewPlanner(units.Hour, units.Day, tasks, routines, periods, blocks)
+	p.SetOverflow(OverflowStrict)
+	return p
+}
+
+func TestImproveIsDeterministic(t *testing.T) {
+	random := rand.New(rand.NewSource(19))
+	for n := range 50 {
+		seed := random.Int63()
+		var tables [][][]TableCell
+		for range 2 {
+			p := randomPlanner(rand.New(rand.NewSource(seed)))
+			table, err := p.GenerateTable()
+			if err != nil {
+				break
+			}
+			tables = append(tables, p.Improve(table, Improvement{Seed: 7}))
+		}
+		if len(tables) == 2 && !slices.EqualFunc(tables[0], tables[1], slices.Equal) {
+			t.Errorf("case %d: the same seed gave %v and %v", n, tables[0], tables[1])
+		}
+	}
+}
+
+func TestImproveKeepsConstraints(t *testing.T) {
+	random := rand.New(rand.NewSource(19))
+	improved := 0
+	for n := range 200 {
+		seed := random.Int63()
+		table, err := randomPlanner(rand.New(rand.NewSource(seed))).GenerateTable()
+		if err != nil {
+			continue
+		}
+
+		// some cells of the table stay where they are
+		pins := make(map[Slot]TableCell)
+		for i, period := range table {
+			for block, cell := range period {
+				if cell.Type == "task" && random.Intn(6) == 0 {
+					pins[Slot{Period: i, Block: block}] = cell
+				}
+			}
+		}
+		p := randomPlanner(rand.New(rand.NewSource(seed)))
+		if err := p.SetPinnedCells(pins); err != nil {
+			t.Fatalf("case %d: SetPinnedCells() error = %v", n, err)
+		}
+		if table, err = p.GenerateTable(); err != nil {
+			continue
+		}
+		routines := routineBlocks(table)
+
+		after := p.Improve(table, Improvement{Seed: int64(n), Iterations: 500})
+		if !slices.EqualFunc(after, table, slices.Equal) {
+			improved++
+		}
+
+		for i, period := range after {
+			used := 0
+			for _, cell := range period {
+				if cell.Type == "task" || cell.Type == "routine" {
+					used++
+				}
+			}
+			if used > p.capacity(i) {
+				t.Errorf("case %d: period %d holds %d blocks, its capacity is %d", n, i, used, p.capacity(i))
+			}
+		}
+		for slot, cell := range pins {
+			if got := after[slot.Period][slot.Block]; got.TodoId != cell.TodoId || !got.Pinned {
+				t.Errorf("case %d: pinned slot (%d, %d) holds %+v", n, slot.Period, slot.Block, got)
+			}
+		}
+		for _, task := range p.tasks {
+			if got := len(p.slotsOf(task.Id, false)); got != task.RequiredTime {
+				t.Errorf("case %d: %s has %d blocks, want %d", n, task.Id, got, task.RequiredTime)
+			}
+			if _, err := p.misplaced(task); err != nil {
+				t.Errorf("case %d: %v", n, err)
+			}
+		}
+		if got := routineBlocks(after); !slices.Equal(got, routines) {
+			t.Errorf("case %d: routine blocks per period went from %v to %v", n, routines, got)
+		}
+	}
+
+	if improved == 0 {
+		t.Error("no table was improved, the cases test nothing")
+	}
+}
+
+// routineBlocks returns the number of routine cells of every period
+func routineBlocks(table [][]TableCell) []int {
+	blocks := make([]int, len(table))
+	for i, period := range table {
+		for _, cell := range period {
+			if cell.Type == "routine" {
+				blocks[i]++
+			}
+		}
+	}
+	return blocks
+}
@@ -0,0 +1,475 @@
+package planner
+
+import (
+	"math"
+	"slices"
+	"sort"
+)
+
+// anchoredCell is a cell that can't be placed before a block of its period
+type anchoredCell struct {
+	block int
+	cell  TableCell
+}
+
+// layoutTable orders the cells of every period so the blocks a todo gets in a
+// period are next to each other, then puts the pinned cells, the blocked slots
+// and the routines kept at the end or at a block back in their place between
+// them, so a cell's position in its period is the block it takes. The blocks
+// of a task with pinned cells in a period go next to them. Free slots before
+// a fixed cell are filled with "free" cells, blocked slots after the last
+// cell of a period are left out.
+func (p *Planner) layoutTable() {
+	for i, period := range p.table {
+		p.table[i] = p.layoutPeriod(i, period)
+	}
+}
+
+func (p *Planner) layoutPeriod(index int, period []TableCell) []TableCell {
+	groups, anchored := p.groupCells(index, period)
+	beside, groups := p.besidePins(index, groups, anchored)
+
+	lastFixed := -1
+	for _, slot := range p.pinnedSlotsIn(index) {
+		lastFixed = slot.Block
+	}
+	for block := range beside {
+		lastFixed = max(lastFixed, block)
+	}
+
+	cells := make([]TableCell, 0, len(period))
+	var current []TableCell
+	for block := 0; len(groups) > 0 || len(current) > 0 || len(anchored) > 0 || block <= lastFixed; block++ {
+		slot := Slot{Period: index, Block: block}
+		if cell, ok := p.pinned_cells[slot]; ok {
+			cells = append(cells, cell)
+			continue
+		}
+		if p.blocked_slots[slot] {
+			cells = append(cells, TableCell{
+				Type: "blocked",
+			})
+			continue
+		}
+		if cell, ok := beside[block]; ok {
+			cells = append(cells, cell)
+			continue
+		}
+		if len(anchored) > 0 && anchored[0].block <= block {
+			cells = append(cells, anchored[0].cell)
+			anchored = anchored[1:]
+			continue
+		}
+
+		// routines at the start go first, then the largest group that fits
+		// before the next fixed cell once its prerequisites are laid out. The
+		// gap is left free when none fits, a group is only split when the
+		// rest of the period has no room for it whole.
+		if len(current) == 0 && len(groups) > 0 {
+			gap := p.gapAt(slot, anchored, beside)
+			next := p.readyGroup(groups, 0)
+			if gap != math.MaxInt && groups[0][0].Type != "routine" {
+				next = p.readyGroup(groups, gap)
+				if next == -1 && p.freeSlotsFrom(index, block+gap, beside)-len(anchored) >= cellsIn(groups) {
+					cells = append(cells, TableCell{
+						Type: "free",
+					})
+					continue
+				}
+				if next == -1 {
+					next = p.readyGroup(groups, 0)
+				}
+			}
+			current = groups[max(next, 0)]
+			groups = slices.Delete(groups, max(next, 0), max(next, 0)+1)
+		}
+		if len(current) > 0 {
+			cells = append(cells, current[0])
+			current = current[1:]
+			continue
+		}
+
+		cells = append(cells, TableCell{
+			Type: "free",
+		})
+	}
+	return cells
+}
+
+// groupCells splits the cells of a period that aren't pinned into groups of
+// the same todo, in the order they were placed, and the cells of the routines
+// kept at the end or at a block, ordered by the block they start at
+func (p *Planner) groupCells(index int, period []TableCell) ([][]TableCell, []anchoredCell) {
+	var groups [][]TableCell
+	groupOf := make(map[TableCell]int)
+	fixed := make(map[string][]TableCell)
+	var fixedIds []string
+	for _, cell := range period {
+		if cell.Pinned {
+			continue
+		}
+
+		if routine, ok := p.findRoutine(cell.TodoId); ok && cell.Type == "routine" &&
+			(routine.Position == "end" || routine.Position == "block") {
+			if _, ok := fixed[routine.Id]; !ok {
+				fixedIds = append(fixedIds, routine.Id)
+			}
+			fixed[routine.Id] = append(fixed[routine.Id], cell)
+			continue
+		}
+
+		if j, ok := groupOf[cell]; ok {
+			groups[j] = append(groups[j], cell)
+			continue
+		}
+		groupOf[cell] = len(groups)
+		groups = append(groups, []TableCell{cell})
+	}
+
+	// routines at the end take the last free slots of the period
+	length := max(p.periodLength(index), len(period))
+	var endCells []TableCell
+	var anchored []anchoredCell
+	for _, id := range fixedIds {
+		routine, _ := p.findRoutine(id)
+		if routine.Position == "end" {
+			endCells = append(endCells, fixed[id]...)
+			continue
+		}
+
+		block := max(min(routine.Block, length-len(fixed[id])), 0)
+		for _, cell := range fixed[id] {
+			anchored = append(anchored, anchoredCell{block: block, cell: cell})
+		}
+	}
+
+	if len(endCells) > 0 {
+		block := length
+		for left := len(endCells); left > 0 && block > 0; {
+			block--
+			slot := Slot{Period: index, Block: block}
+			if p.isFree(slot) {
+				left--
+			}
+		}
+		for _, cell := range endCells {
+			anchored = append(anchored, anchoredCell{block: block, cell: cell})
+		}
+	}
+
+	sort.SliceStable(anchored, func(i, j int) bool {
+		return anchored[i].block < anchored[j].block
+	})
+	return groups, anchored
+}
+
+// besidePins takes the cells of the tasks with pinned cells in a period out
+// of their groups and gives them the blocks next to their pinned cells: the
+// free blocks between them first, then the ones right before and after
+// them. The cells there's no room for next to them stay in their groups.
+func (p *Planner) besidePins(index int, groups [][]TableCell, anchored []anchoredCell) (map[int]TableCell, [][]TableCell) {
+	pinned := make(map[string][]int)
+	for _, slot := range p.pinnedSlotsIn(index) {
+		if cell := p.pinned_cells[slot]; cell.Type == "task" {
+			pinned[cell.TodoId] = append(pinned[cell.TodoId], slot.Block)
+		}
+	}
+	if len(pinned) == 0 {
+		return nil, groups
+	}
+
+	// the blocks the anchored cells are laid out at
+	taken := make(map[int]bool)
+	block := 0
+	for _, cell := range anchored {
+		block = max(block, cell.block)
+		for !p.isFree(Slot{Period: index, Block: block}) {
+			block++
+		}
+		taken[block] = true
+		block++
+	}
+
+	length := p.periodLength(index)
+	beside := make(map[int]TableCell)
+	free := func(block int) bool {
+		_, ok := beside[block]
+		return block >= 0 && block < length && !ok && !taken[block] && p.isFree(Slot{Period: index, Block: block})
+	}
+
+	var left [][]TableCell
+	for _, group := range groups {
+		blocks, ok := pinned[group[0].TodoId]
+		if !ok || group[0].Type != "task" {
+			left = append(left, group)
+			continue
+		}
+
+		first, last := blocks[0], blocks[len(blocks)-1]
+		var targets []int
+		for block := first + 1; block < last; block++ {
+			if free(block) {
+				targets = append(targets, block)
+			}
+		}
+
+		var after, before []int
+		for block := last + 1; free(block); block++ {
+			after = append(after, block)
+		}
+		for block := first - 1; free(block); block-- {
+			before = append(before, block)
+		}
+
+		// the rest goes on the two sides in the split that leaves the most
+		// free blocks next to the pinned cells of other tasks, then the
+		// longest free run, then when tasks wait for it the most free
+		// blocks after it for them
+		rest := len(group) - len(targets)
+		afterContested := p.pinnedTaskAt(index, last+1+len(after), group[0].TodoId)
+		beforeContested := p.pinnedTaskAt(index, first-1-len(before), group[0].TodoId)
+		waited := len(p.dependentsOf(group[0].TodoId)) > 0
+		split, best := -1, [3]int{}
+		for b := max(rest-len(after), 0); b <= min(rest, len(before)); b++ {
+			leftBefore, leftAfter := len(before)-b, len(after)-(rest-b)
+			score := [3]int{0, max(leftBefore, leftAfter), 0}
+			if beforeContested {
+				score[0] += leftBefore
+			}
+			if afterContested {
+				score[0] += leftAfter
+			}
+			if waited {
+				score[2] = leftAfter
+			}
+			if split == -1 || slices.Compare(score[:], best[:]) > 0 {
+				split, best = b, score
+			}
+		}
+		if split == -1 {
+			targets = append(targets, after...)
+			targets = append(targets, before...)
+		} else {
+			targets = append(targets, before[:split]...)
+			targets = append(targets, after[:rest-split]...)
+		}
+
+		targets = targets[:min(len(targets), len(group))]
+		for j, block := range targets {
+			beside[block] = group[j]
+		}
+		if rest := group[len(targets):]; len(rest) > 0 {
+			left = append(left, rest)
+		}
+	}
+	return beside, left
+}
+
+// pinnedTaskAt reports whether a block of a period holds a pinned cell of a
+// task other than the one with the given id
+func (p *Planner) pinnedTaskAt(index int, block int, id string) bool {
+	cell, ok := p.pinned_cells[Slot{Period: index, Block: block}]
+	return ok && cell.Type == "task" && cell.TodoId != id
+}
+
+// isFree reports whether a slot is neither pinned nor blocked
+func (p *Planner) isFree(slot Slot) bool {
+	_, pinned := p.pinned_cells[slot]
+	return !pinned && !p.blocked_slots[slot]
+}
+
+// gapAt returns the number of slots from slot to the next pinned cell,
+// blocked slot, cell next to pinned cells or anchored cell of its period
+func (p *Planner) gapAt(slot Slot, anchored []anchoredCell, beside map[int]TableCell) int {
+	gap := math.MaxInt
+	if len(anchored) > 0 {
+		gap = max(anchored[0].block-slot.Block, 0)
+	}
+	for block := range beside {
+		if block >= slot.Block {
+			gap = min(gap, block-slot.Block)
+		}
+	}
+	for pinned := range p.pinned_cells {
+		if pinned.Period == slot.Period && pinned.Block >= slot.Block {
+			gap = min(gap, pinned.Block-slot.Block)
+		}
+	}
+	for blocked := range p.blocked_slots {
+		if blocked.Period == slot.Period && blocked.Block >= slot.Block {
+			gap = min(gap, blocked.Block-slot.Block)
+		}
+	}
+	return gap
+}
+
+// readyGroup returns the index of the largest group of at most gap cells
+// whose task has no prerequisite left in groups, the first of them when gap
+// is 0 and -1 when none is
+func (p *Planner) readyGroup(groups [][]TableCell, gap int) int {
+	next := -1
+	for j, group := range groups {
+		if gap > 0 && len(group) > gap {
+			continue
+		}
+		if task, ok := p.findTask(group[0].TodoId); ok && group[0].Type == "task" &&
+			slices.ContainsFunc(groups, func(other []TableCell) bool {
+				return other[0].Type == "task" && slices.Contains(task.Prerequisites, other[0].TodoId)
+			}) {
+			continue
+		}
+		if gap == 0 {
+			return j
+		}
+		if next == -1 || len(group) > len(groups[next]) {
+			next = j
+		}
+	}
+	return next
+}
+
+// cellsIn returns the number of cells of groups
+func cellsIn(groups [][]TableCell) int {
+	count := 0
+	for _, group := range groups {
+		count += len(group)
+	}
+	return count
+}
+
+// freeSlotsFrom returns the number of slots of a period from a block on
+// that aren't pinned, blocked or taken next to pinned cells
+func (p *Planner) freeSlotsFrom(index int, block int, beside map[int]TableCell) int {
+	count := 0
+	for ; block < p.periodLength(index); block++ {
+		if _, ok := beside[block]; !ok && p.isFree(Slot{Period: index, Block: block}) {
+			count++
+		}
+	}
+	return count
+}
+
+// keepsTogether reports whether laying out the cells of a period keeps the
+// blocks every task gets in it next to each other and after the blocks of
+// its prerequisites, inside the period
+func (p *Planner) keepsTogether(index int, period []TableCell) bool {
+	laidOut := p.layoutPeriod(index, period)
+	return len(laidOut) <= p.periodLength(index) && len(splitTasks(laidOut)) == 0 && p.inOrder(laidOut)
+}
+
+// inOrder reports whether the tasks of a laid out period come after their
+// prerequisites in it, pinned cells in another order aside
+func (p *Planner) inOrder(period []TableCell) bool {
+	last := make(map[string]int)
+	lastUnpinned := make(map[string]int)
+	for block, cell := range period {
+		if cell.Type == "task" {
+			last[cell.TodoId] = block
+			if !cell.Pinned {
+				lastUnpinned[cell.TodoId] = block
+			}
+		}
+	}
+
+	for block, cell := range period {
+		if cell.Type != "task" {
+			continue
+		}
+		ends := last
+		if cell.Pinned {
+			ends = lastUnpinned
+		}
+		task, _ := p.findTask(cell.TodoId)
+		for _, id := range task.Prerequisites {
+			if end, ok := ends[id]; ok && end > block {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// fitsWhole reports whether a period keeps the blocks of every task in it
+// together once it gets blocks more cells of a task
+func (p *Planner) fitsWhole(id string, index int, blocks int) bool {
+	period := slices.Clone(p.table[index])
+	for range blocks {
+		period = append(period, TableCell{
+			Type:   "task",
+			TodoId: id,
+		})
+	}
+	return p.keepsTogether(index, period)
+}
+
+// splitTasks returns the ids of the tasks whose blocks a laid out period
+// doesn't keep next to each other, their pinned cells included. Tasks with
+// only pinned cells in the period keep them where they are.
+func splitTasks(period []TableCell) []string {
+	var ids []string
+	last := make(map[string]int)
+	broken := make(map[string]bool)
+	unpinned := make(map[string]bool)
+	for block, cell := range period {
+		if cell.Type != "task" {
+			continue
+		}
+		if previous, ok := last[cell.TodoId]; !ok {
+			ids = append(ids, cell.TodoId)
+		} else if previous != block-1 {
+			broken[cell.TodoId] = true
+		}
+		last[cell.TodoId] = block
+		unpinned[cell.TodoId] = unpinned[cell.TodoId] || !cell.Pinned
+	}
+
+	var split []string
+	for _, id := range ids {
+		if broken[id] && unpinned[id] {
+			split = append(split, id)
+		}
+	}
+	return split
+}
+
+// largestRun returns the most blocks of a period a chunk can take without
+// being split by a pinned cell, a blocked slot or a routine kept at the end
+// or at a block, once the routines at the start are laid out. The pinned
+// cells of the task with the given id, if any, are part of its chunk. The
+// tasks already in the period aside, it bounds the chunks fitsWhole can accept.
+func (p *Planner) largestRun(index int, id string) int {
+	groups, anchored := p.groupCells(index, p.table[index])
+	leading := 0
+	for _, group := range groups {
+		if group[0].Type == "routine" {
+			leading += len(group)
+		}
+	}
+
+	largest, run := 0, 0
+	for block := range p.periodLength(index) {
+		slot := Slot{Period: index, Block: block}
+		if cell, ok := p.pinned_cells[slot]; ok && cell.Type == "task" && cell.TodoId == id {
+			run++
+			largest = max(largest, run)
+			continue
+		}
+		fixed := !p.isFree(slot)
+		if !fixed && len(anchored) > 0 && anchored[0].block <= block {
+			anchored = anchored[1:]
+			fixed = true
+		}
+		if !fixed && leading > 0 {
+			leading--
+			fixed = true
+		}
+
+		if fixed {
+			run = 0
+			continue
+		}
+		run++
+		largest = max(largest, run)
+	}
+	return largest
+}
@@ -0,0 +1,123 @@
+package planner
+
+import (
+	"planner-microservice/units"
+	"slices"
+	"testing"
+)
+
+func TestLayoutTable(t *testing.T) {
+	tests := []struct {
+		name     string
+		tasks    []Task
+		routines []Routine
+		blocked  []Slot
+		periods  int
+		blocks   int
+	}{
+		{
+			name: "prerequisite before a blocked slot",
+			tasks: []Task{
+				{Todo: Todo{Id: "a", Title: "a", RequiredTime: 1}, Priority: 1, IsBreakable: true},
+				{Todo: Todo{Id: "b", Title: "b", RequiredTime: 3}, Priority: 1, IsBreakable: true, Prerequisites: []string{"a"}},
+			},
+			blocked: []Slot{{Period: 0, Block: 3}},
+			periods: 1,
+			blocks:  6,
+		},
+		{
+			name: "routine at a block",
+			tasks: []Task{
+				{Todo: Todo{Id: "a", Title: "a", RequiredTime: 3}, Priority: 1, IsBreakable: true},
+			},
+			routines: []Routine{
+				{Todo: Todo{Id: "review", Title: "review", RequiredTime: 1}, Position: "block", Block: 2},
+			},
+			periods: 2,
+			blocks:  4,
+		},
+		{
+			name: "unbreakable task and a blocked slot",
+			tasks: []Task{
+				{Todo: Todo{Id: "a", Title: "a", RequiredTime: 3}, Priority: 1},
+				{Todo: Todo{Id: "b", Title: "b", RequiredTime: 2}, Priority: 1},
+			},
+			blocked: []Slot{{Period: 0, Block: 2}},
+			periods: 2,
+			blocks:  5,
+		},
+		{
+			name: "unbreakable tasks around a blocked slot",
+			tasks: []Task{
+				{Todo: Todo{Id: "a", Title: "a", RequiredTime: 3}, Priority: 1},
+				{Todo: Todo{Id: "b", Title: "b", RequiredTime: 2}, Priority: 1},
+			},
+			blocked: []Slot{{Period: 0, Block: 4}},
+			periods: 2,
+			blocks:  6,
+		},
+		{
+			name: "unbreakable task and a routine at a block",
+			tasks: []Task{
+				{Todo: Todo{Id: "a", Title: "a", RequiredTime: 2}, Priority: 1},
+			},
+			routines: []Routine{
+				{Todo: Todo{Id: "review", Title: "review", RequiredTime: 2}, Position: "block", Block: 1},
+			},
+			periods: 2,
+			blocks:  4,
+		},
+	}
+
+	for _, tt := range tests {
+		for _, strategy := range Strategies() {
+			t.Run(tt.name+"/"+strategy.Name(), func(t *testing.T) {
+				p := NewPlanner(units.Hour, units.Day, tt.tasks, tt.routines, tt.periods, tt.blocks)
+				p.SetBlockedSlots(tt.blocked)
+				p.SetStrategy(strategy)
+
+				table, err := p.GenerateTable()
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, task := range tt.tasks {
+					placed := 0
+					for i := range table {
+						placed += p.taskBlocksIn(task.Id, i)
+					}
+					if placed != task.RequiredTime {
+						t.Errorf("%s has %d blocks, want %d", task.Id, placed, task.RequiredTime)
+					}
+				}
+				for i, period := range table {
+					if len(period) > tt.blocks {
+						t.Errorf("period %d has %d blocks, want at most %d", i, len(period), tt.blocks)
+					}
+					for _, task := range tt.tasks {
+						first, last := -1, -1
+						for block, cell := range period {
+							if cell.TodoId != task.Id {
+								continue
+							}
+							if first == -1 {
+								first = block
+							}
+							last = block
+						}
+						if first == -1 {
+							continue
+						}
+						if last-first+1 != p.taskBlocksIn(task.Id, i) {
+							t.Errorf("period %d splits %s: %v", i, task.Id, period)
+						}
+						for _, id := range task.Prerequisites {
+							if slices.ContainsFunc(period[first:], func(cell TableCell) bool { return cell.TodoId == id }) {
+								t.Errorf("period %d lays out %s before its prerequisite %s: %v", i, task.Id, id, period)
+							}
+						}
+					}
+				}
+			})
+		}
+	}
+}
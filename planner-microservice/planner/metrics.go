@@ -0,0 +1,118 @@
+package planner
+
+import "math"
+
+// Metrics describes the shape of a table, to compare plans and tell users why one looks lopsided
+type Metrics struct {
+	Utilisation        []float64 // used share of the capacity of every period, 0 for blocked periods
+	LoadVariance       float64   // variance of the utilisation of the periods with capacity
+	Fragments          int       // runs of consecutive blocks of the same task
+	ContextSwitches    []int     // changes from a todo to another in every period
+	HighPriorityPeriod int       // last period holding the most urgent tasks, -1 when there are none
+	HighPriorityFinish float64   // share of the plan gone when the most urgent tasks are done, 0 when there are none
+	UnusedCapacity     int       // blocks that could hold a todo and hold none
+	Score              float64   // 0 to 100, higher is better
+}
+
+// Evaluate measures a table of the planner's todos, generated or edited by the user,
+// periods past the planner's capacities count with n_blocks
+func (p *Planner) Evaluate(table [][]TableCell) Metrics {
+	metrics := Metrics{
+		Utilisation:        make([]float64, len(table)),
+		ContextSwitches:    make([]int, len(table)),
+		HighPriorityPeriod: -1,
+	}
+
+	// the most urgent tasks are those of the highest priority in the plan
+	urgent := make(map[string]bool)
+	highest := math.MinInt
+	for _, task := range p.tasks {
+		highest = max(highest, task.Priority)
+	}
+	for _, task := range p.tasks {
+		if task.Priority == highest {
+			urgent[task.Id] = true
+		}
+	}
+
+	var utilisations []float64
+	tasks := make(map[string]bool)
+	transitions := 0
+	for i, period := range table {
+		used := 0
+		previous := -1 // index of the previous todo cell of the period
+		for j, cell := range period {
+			if cell.Type != "task" && cell.Type != "routine" {
+				continue
+			}
+			used++
+
+			if previous >= 0 {
+				transitions++
+				if period[previous].TodoId != cell.TodoId || period[previous].Type != cell.Type {
+					metrics.ContextSwitches[i]++
+				}
+			}
+			if cell.Type == "task" {
+				tasks[cell.TodoId] = true
+				if previous < 0 || previous != j-1 || period[previous].TodoId != cell.TodoId || period[previous].Type != "task" {
+					metrics.Fragments++
+				}
+				if urgent[cell.TodoId] {
+					metrics.HighPriorityPeriod = i
+					metrics.HighPriorityFinish = (float64(i) + float64(j+1)/float64(len(period))) / float64(len(table))
+				}
+			}
+			previous = j
+		}
+
+		capacity := max(p.capacity(i), used)
+		if capacity == 0 {
+			continue
+		}
+		metrics.Utilisation[i] = float64(used) / float64(capacity)
+		metrics.UnusedCapacity += capacity - used
+		utilisations = append(utilisations, metrics.Utilisation[i])
+	}
+	metrics.LoadVariance = variance(utilisations)
+
+	switches := 0
+	for _, count := range metrics.ContextSwitches {
+		switches += count
+	}
+
+	// every part of the score is 1 for the best plan and 0 for the worst:
+	// even load, a single run per task, no context switches and urgent work done first
+	balance := 1 - min(2*math.Sqrt(metrics.LoadVariance), 1)
+	compactness := 1.0
+	if metrics.Fragments > 0 {
+		compactness = float64(len(tasks)) / float64(metrics.Fragments)
+	}
+	focus := 1.0
+	if transitions > 0 {
+		focus = 1 - float64(switches)/float64(transitions)
+	}
+	urgency := 1 - metrics.HighPriorityFinish
+	metrics.Score = 100 * (balance + compactness + focus + urgency) / 4
+
+	return metrics
+}
+
+// variance returns the population variance of values, 0 when there are none
+func variance(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	mean := 0.0
+	for _, value := range values {
+		mean += value
+	}
+	mean /= float64(len(values))
+
+	sum := 0.0
+	for _, value := range values {
+		sum += (value - mean) * (value - mean)
+	}
+	return sum / float64(len(values))
+}
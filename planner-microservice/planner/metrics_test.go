@@ -0,0 +1,144 @@
+package planner
+
+import (
+	"math"
+	"planner-microservice/units"
+	"slices"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	tasks := []Task{
+		{Todo: Todo{Id: "a", Title: "a", RequiredTime: 3}, Priority: 2, IsBreakable: true},
+		{Todo: Todo{Id: "b", Title: "b", RequiredTime: 2}, Priority: 1, IsBreakable: true},
+	}
+	routines := []Routine{{Todo: Todo{Id: "r", Title: "r", RequiredTime: 1}, Position: "end"}}
+	a := TableCell{Type: "task", TodoId: "a"}
+	b := TableCell{Type: "task", TodoId: "b"}
+	r := TableCell{Type: "routine", TodoId: "r"}
+	free := TableCell{Type: "free"}
+	blocked := TableCell{Type: "blocked"}
+
+	tests := []struct {
+		name    string
+		tasks   []Task
+		periods int
+		blocked []int
+		table   [][]TableCell
+		want    Metrics
+	}{
+		{
+			name:    "split tasks and a blocked period",
+			tasks:   tasks,
+			periods: 3,
+			blocked: []int{2},
+			table: [][]TableCell{
+				{a, a, b, r},
+				{b, free, a, free},
+				{blocked, blocked, blocked, blocked},
+			},
+			want: Metrics{
+				Utilisation:        []float64{1, 0.5, 0},
+				LoadVariance:       0.0625,
+				Fragments:          4,
+				ContextSwitches:    []int{2, 1, 0},
+				HighPriorityPeriod: 1,
+				// the last block of a is the third of four in the second of three periods
+				HighPriorityFinish: 1.75 / 3,
+				UnusedCapacity:     2,
+				// balance 0.5, compactness 0.5, focus 0.25, urgency 1 - 1.75/3
+				Score: 100 * (0.5 + 0.5 + 0.25 + 1 - 1.75/3) / 4,
+			},
+		},
+		{
+			name:    "urgent task first in single runs",
+			tasks:   tasks,
+			periods: 2,
+			table: [][]TableCell{
+				{a, a, a, r},
+				{b, b, r, free},
+			},
+			want: Metrics{
+				Utilisation:        []float64{1, 0.75},
+				LoadVariance:       0.015625,
+				Fragments:          2,
+				ContextSwitches:    []int{1, 1},
+				HighPriorityPeriod: 0,
+				HighPriorityFinish: 0.75 / 2,
+				UnusedCapacity:     1,
+				Score:              100 * (0.75 + 1 + 0.6 + 1 - 0.375) / 4,
+			},
+		},
+		{
+			name:    "appended period counts with n_blocks",
+			tasks:   tasks,
+			periods: 1,
+			table: [][]TableCell{
+				{a, a, a, b},
+				{b, free, free, free},
+			},
+			want: Metrics{
+				Utilisation:        []float64{1, 0.25},
+				LoadVariance:       0.140625,
+				Fragments:          3,
+				ContextSwitches:    []int{1, 0},
+				HighPriorityPeriod: 0,
+				HighPriorityFinish: 0.75 / 2,
+				UnusedCapacity:     3,
+				Score:              100 * (0.25 + 2.0/3 + 2.0/3 + 1 - 0.375) / 4,
+			},
+		},
+		{
+			name:    "empty periods",
+			periods: 2,
+			table: [][]TableCell{
+				{free, free, free, free},
+				{free, free, free, free},
+			},
+			want: Metrics{
+				Utilisation:        []float64{0, 0},
+				ContextSwitches:    []int{0, 0},
+				HighPriorityPeriod: -1,
+				UnusedCapacity:     8,
+				Score:              100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPlanner(units.Hour, units.Day, tt.tasks, routines, tt.periods, 4)
+			p.SetBlockedPeriods(tt.blocked)
+
+			got := p.Evaluate(tt.table)
+			if !slices.EqualFunc(got.Utilisation, tt.want.Utilisation, near) {
+				t.Errorf("Utilisation = %v, want %v", got.Utilisation, tt.want.Utilisation)
+			}
+			if !near(got.LoadVariance, tt.want.LoadVariance) {
+				t.Errorf("LoadVariance = %v, want %v", got.LoadVariance, tt.want.LoadVariance)
+			}
+			if got.Fragments != tt.want.Fragments {
+				t.Errorf("Fragments = %d, want %d", got.Fragments, tt.want.Fragments)
+			}
+			if !slices.Equal(got.ContextSwitches, tt.want.ContextSwitches) {
+				t.Errorf("ContextSwitches = %v, want %v", got.ContextSwitches, tt.want.ContextSwitches)
+			}
+			if got.HighPriorityPeriod != tt.want.HighPriorityPeriod {
+				t.Errorf("HighPriorityPeriod = %d, want %d", got.HighPriorityPeriod, tt.want.HighPriorityPeriod)
+			}
+			if !near(got.HighPriorityFinish, tt.want.HighPriorityFinish) {
+				t.Errorf("HighPriorityFinish = %v, want %v", got.HighPriorityFinish, tt.want.HighPriorityFinish)
+			}
+			if got.UnusedCapacity != tt.want.UnusedCapacity {
+				t.Errorf("UnusedCapacity = %d, want %d", got.UnusedCapacity, tt.want.UnusedCapacity)
+			}
+			if !near(got.Score, tt.want.Score) {
+				t.Errorf("Score = %v, want %v", got.Score, tt.want.Score)
+			}
+		})
+	}
+}
+
+// near reports whether two measures are equal but for rounding
+func near(a float64, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
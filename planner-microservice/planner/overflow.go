@@ -0,0 +1,96 @@
+package planner
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// Overflow decides what happens to the todos that don't fit in the requested periods
+type Overflow int
+
+const (
+	// OverflowGrow appends periods to the plan until every task fits
+	OverflowGrow Overflow = iota
+	// OverflowStrict fails instead of appending a period or leaving a todo out
+	OverflowStrict
+	// OverflowBestEffort keeps the requested periods, places what fits by
+	// priority and reports the rest as unscheduled
+	OverflowBestEffort
+)
+
+// TodoDoesNotFit is the constraint strict plans fail when a todo doesn't fit in the requested periods
+const TodoDoesNotFit = "todo_does_not_fit"
+
+// capacityConstraints are the constraints best-effort plans don't fail,
+// the todos they leave out are reported instead
+var capacityConstraints = []string{
+	RoutinesExceedBlocks,
+	TasksExceedCapacity,
+	UnbreakableTaskTooLong,
+	TaskWindowOverloaded,
+	ChunkTooLarge,
+}
+
+// CapacityError is returned by GenerateTable for strict plans that don't
+// fit in the requested periods, every todo left out is an infeasibility
+type CapacityError struct {
+	Infeasibilities []Infeasibility
+}
+
+func (e *CapacityError) Error() string {
+	descriptions := make([]string, len(e.Infeasibilities))
+	for i, infeasibility := range e.Infeasibilities {
+		descriptions[i] = infeasibility.Description
+	}
+	return "the todos don't fit in the requested periods: " + strings.Join(descriptions, "; ")
+}
+
+// SetOverflow chooses what happens to the todos that don't fit, OverflowGrow by default
+func (p *Planner) SetOverflow(overflow Overflow) {
+	p.overflow = overflow
+}
+
+// Overflow returns what happens to the todos that don't fit
+func (p *Planner) Overflow() Overflow {
+	return p.overflow
+}
+
+// canGrow reports whether periods may be appended to make room for a task
+func (p *Planner) canGrow() bool {
+	return p.overflow == OverflowGrow
+}
+
+// capacityError explains every todo a strict plan leaves out, nil when none
+// is. Plans that grow fail with the first task left out, best-effort plans
+// only report them.
+func (p *Planner) capacityError() error {
+	if p.canGrow() {
+		for _, todo := range p.unscheduled {
+			if todo.Type == "task" {
+				return errors.New(todo.Reason)
+			}
+		}
+	}
+	if p.overflow != OverflowStrict || len(p.unscheduled) == 0 {
+		return nil
+	}
+
+	err := &CapacityError{}
+	for _, todo := range p.unscheduled {
+		infeasibility := Infeasibility{
+			Constraint:  TodoDoesNotFit,
+			Description: todo.Reason,
+			TodoIds:     []string{todo.TodoId},
+			FirstPeriod: 0,
+			LastPeriod:  p.n_periods - 1,
+			Shortfall:   todo.Missing,
+		}
+		if todo.Type == "routine" {
+			infeasibility.FirstPeriod = slices.Min(todo.Periods)
+			infeasibility.LastPeriod = slices.Max(todo.Periods)
+		}
+		err.Infeasibilities = append(err.Infeasibilities, infeasibility)
+	}
+	return err
+}
@@ -0,0 +1,201 @@
+package planner
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PinError lists the cells SetPinnedCells can't pin, ordered by slot
+type PinError struct {
+	Pins []InvalidPin
+}
+
+// InvalidPin is a cell that can't be pinned in its slot
+type InvalidPin struct {
+	Slot        Slot
+	Description string // why, starting with the slot
+}
+
+func (e *PinError) Error() string {
+	descriptions := make([]string, len(e.Pins))
+	for i, pin := range e.Pins {
+		descriptions[i] = "pinned slot " + pin.Description
+	}
+	return strings.Join(descriptions, "; ")
+}
+
+// SetPinnedCells keeps cells of an existing plan in their slots,
+// the planner only fills the capacity left around them. The cells that
+// can't be pinned are returned in a *PinError.
+func (p *Planner) SetPinnedCells(cells map[Slot]TableCell) error {
+	slots := make([]Slot, 0, len(cells))
+	for slot := range cells {
+		slots = append(slots, slot)
+	}
+	sortSlots(slots)
+
+	var invalid []InvalidPin
+	reject := func(slot Slot, format string, args ...any) {
+		invalid = append(invalid, InvalidPin{
+			Slot:        slot,
+			Description: fmt.Sprintf("(%d, %d) ", slot.Period, slot.Block) + fmt.Sprintf(format, args...),
+		})
+	}
+
+	p.pinned_cells = make(map[Slot]TableCell, len(cells))
+	for _, slot := range slots {
+		cell := cells[slot]
+		switch {
+		case slot.Period < 0 || slot.Period >= p.n_periods || slot.Block < 0:
+			reject(slot, "is outside the plan")
+		case p.blocked_periods[slot.Period] || p.blocked_slots[slot]:
+			reject(slot, "is blocked")
+		case !p.hasTodo(cell.Type, cell.TodoId):
+			reject(slot, "has an unknown %s %q", cell.Type, cell.TodoId)
+		default:
+			cell.Pinned = true
+			p.pinned_cells[slot] = cell
+		}
+	}
+
+	for _, task := range p.tasks {
+		p.checkPins(task, reject)
+	}
+	if len(invalid) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(invalid, func(i, j int) bool {
+		return slotBefore(invalid[i].Slot, invalid[j].Slot)
+	})
+	return &PinError{Pins: invalid}
+}
+
+// checkPins rejects the pinned cells of a task outside the periods it can
+// take, and the ones of an unbreakable task that split it or leave no room
+// for the rest of it next to them
+func (p *Planner) checkPins(task Task, reject func(Slot, string, ...any)) {
+	var slots []Slot
+	for i := range p.n_periods {
+		for _, slot := range p.pinnedSlotsIn(i) {
+			if cell := p.pinned_cells[slot]; cell.Type == "task" && cell.TodoId == task.Id {
+				slots = append(slots, slot)
+			}
+		}
+	}
+
+	deadline, hasDeadline := p.deadlineOf(task)
+	for _, slot := range slots {
+		if task.HasEarliestStart() && slot.Period < *task.EarliestStart {
+			reject(slot, "is before the earliest start of task %q", task.Title)
+		}
+		if hasDeadline && slot.Period > deadline {
+			reject(slot, "is after the deadline of task %q", task.Title)
+		}
+	}
+	if task.IsBreakable || len(slots) == 0 {
+		return
+	}
+
+	first := slots[0]
+	var blocks []int
+	for _, slot := range slots {
+		if slot.Period != first.Period {
+			reject(slot, "splits task %q over periods %d and %d, it can't be broken up", task.Title, first.Period, slot.Period)
+			continue
+		}
+		blocks = append(blocks, slot.Block)
+	}
+
+	// the rest of it takes the free blocks between, before and after them
+	start, end := blocks[0], blocks[len(blocks)-1]
+	for block := start; block <= end; block++ {
+		slot := Slot{Period: first.Period, Block: block}
+		if cell, ok := p.pinned_cells[slot]; (ok && (cell.Type != "task" || cell.TodoId != task.Id)) || p.blocked_slots[slot] {
+			reject(first, "splits task %q within period %d, it can't be broken up", task.Title, first.Period)
+			return
+		}
+	}
+	for end+1 < p.periodLength(first.Period) && p.isFree(Slot{Period: first.Period, Block: end + 1}) {
+		end++
+	}
+	for start > 0 && p.isFree(Slot{Period: first.Period, Block: start - 1}) {
+		start--
+	}
+	if end-start+1 < task.RequiredTime {
+		reject(first, "leaves %d blocks for the %d of task %q, it can't be broken up", end-start+1, task.RequiredTime, task.Title)
+	}
+}
+
+// sortSlots orders slots by period, then by block
+func sortSlots(slots []Slot) {
+	sort.Slice(slots, func(i, j int) bool {
+		return slotBefore(slots[i], slots[j])
+	})
+}
+
+// slotBefore reports whether a slot comes before another one in the plan
+func slotBefore(a Slot, b Slot) bool {
+	return a.Period < b.Period || (a.Period == b.Period && a.Block < b.Block)
+}
+
+// pinnedPeriod returns the first period a task has pinned cells in
+func (p *Planner) pinnedPeriod(id string) (int, bool) {
+	period, ok := 0, false
+	for slot, cell := range p.pinned_cells {
+		if cell.Type == "task" && cell.TodoId == id && (!ok || slot.Period < period) {
+			period, ok = slot.Period, true
+		}
+	}
+	return period, ok
+}
+
+func (p *Planner) hasTodo(_type string, todoId string) bool {
+	switch _type {
+	case "task":
+		_, ok := p.findTask(todoId)
+		return ok
+	case "routine":
+		_, ok := p.findRoutine(todoId)
+		return ok
+	}
+	return false
+}
+
+// pinnedSlotsIn returns the pinned slots of a period ordered by block
+func (p *Planner) pinnedSlotsIn(index int) []Slot {
+	var slots []Slot
+	for slot := range p.pinned_cells {
+		if slot.Period == index {
+			slots = append(slots, slot)
+		}
+	}
+	sortSlots(slots)
+	return slots
+}
+
+// pinnedTime returns the number of pinned cells of a todo,
+// in a single period or in the whole plan when index is -1
+func (p *Planner) pinnedTime(_type string, todoId string, index int) int {
+	count := 0
+	for slot, cell := range p.pinned_cells {
+		if cell.Type == _type && cell.TodoId == todoId && (index == -1 || slot.Period == index) {
+			count++
+		}
+	}
+	return count
+}
+
+// remainingTime returns the blocks of a task that aren't pinned yet
+func (p *Planner) remainingTime(task Task) int {
+	return max(task.RequiredTime-p.pinnedTime("task", task.Id, -1), 0)
+}
+
+// missingRoutineTime returns the blocks a routine still needs in a period
+func (p *Planner) missingRoutineTime(routine Routine, index int) int {
+	if !p.occursIn(routine, index) {
+		return 0
+	}
+	return max(routine.RequiredTime-p.pinnedTime("routine", routine.Id, index), 0)
+}
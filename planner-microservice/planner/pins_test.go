@@ -0,0 +1,229 @@
+package planner
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"planner-microservice/units"
+	"slices"
+	"testing"
+)
+
+func TestSetPinnedCells(t *testing.T) {
+	deadline, start := 0, 1
+	tasks := []Task{
+		{Todo: Todo{Id: "late", Title: "late", RequiredTime: 2}, Priority: 1, IsBreakable: true, Deadline: &deadline},
+		{Todo: Todo{Id: "early", Title: "early", RequiredTime: 2}, Priority: 1, IsBreakable: true, EarliestStart: &start},
+		{Todo: Todo{Id: "whole", Title: "whole", RequiredTime: 3}, Priority: 1},
+		{Todo: Todo{Id: "first", Title: "first", RequiredTime: 1}, Priority: 1, IsBreakable: true},
+		{Todo: Todo{Id: "then", Title: "then", RequiredTime: 1}, Priority: 1, IsBreakable: true, Prerequisites: []string{"first"}},
+	}
+	task := func(id string) TableCell {
+		return TableCell{Type: "task", TodoId: id}
+	}
+
+	tests := []struct {
+		name  string
+		cells map[Slot]TableCell
+		want  []string
+	}{
+		{
+			name:  "part of an unbreakable task",
+			cells: map[Slot]TableCell{{Period: 0, Block: 1}: task("whole"), {Period: 0, Block: 3}: task("whole")},
+		},
+		{
+			name: "outside the plan, blocked or unknown",
+			cells: map[Slot]TableCell{
+				{Period: 3, Block: 0}: task("late"),
+				{Period: 1, Block: 1}: task("early"),
+				{Period: 2, Block: 0}: task("missing"),
+			},
+			want: []string{
+				`(1, 1) is blocked`,
+				`(2, 0) has an unknown task "missing"`,
+				`(3, 0) is outside the plan`,
+			},
+		},
+		{
+			name:  "outside the window",
+			cells: map[Slot]TableCell{{Period: 1, Block: 0}: task("late"), {Period: 0, Block: 0}: task("early")},
+			want: []string{
+				`(0, 0) is before the earliest start of task "early"`,
+				`(1, 0) is after the deadline of task "late"`,
+			},
+		},
+		{
+			name:  "after the task waiting for it",
+			cells: map[Slot]TableCell{{Period: 0, Block: 0}: task("then"), {Period: 1, Block: 0}: task("first")},
+			want:  []string{`(1, 0) is after the deadline of task "first"`},
+		},
+		{
+			name:  "unbreakable task over two periods",
+			cells: map[Slot]TableCell{{Period: 0, Block: 0}: task("whole"), {Period: 2, Block: 0}: task("whole")},
+			want:  []string{`(2, 0) splits task "whole" over periods 0 and 2, it can't be broken up`},
+		},
+		{
+			name: "unbreakable task around another one",
+			cells: map[Slot]TableCell{
+				{Period: 0, Block: 0}: task("whole"),
+				{Period: 0, Block: 1}: task("first"),
+				{Period: 0, Block: 2}: task("whole"),
+			},
+			want: []string{`(0, 0) splits task "whole" within period 0, it can't be broken up`},
+		},
+		{
+			name:  "no room for an unbreakable task",
+			cells: map[Slot]TableCell{{Period: 1, Block: 2}: task("whole")},
+			want:  []string{`(1, 2) leaves 2 blocks for the 3 of task "whole", it can't be broken up`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the map is walked in a different order every time
+			for range 10 {
+				p := NewPlanner(units.Hour, units.Day, tasks, nil, 3, 4)
+				p.SetBlockedSlots([]Slot{{Period: 1, Block: 1}})
+
+				err := p.SetPinnedCells(tt.cells)
+				var pinErr *PinError
+				if err != nil && !errors.As(err, &pinErr) {
+					t.Fatalf("SetPinnedCells() error = %v, want a *PinError", err)
+				}
+
+				var got []string
+				if pinErr != nil {
+					for _, pin := range pinErr.Pins {
+						got = append(got, pin.Description)
+					}
+				}
+				if !slices.Equal(got, tt.want) {
+					t.Fatalf("SetPinnedCells() rejects %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// TestReplanBesidePins checks that the rest of a task goes next to its pinned
+// cells, so an unbreakable task stays whole and a chunk keeps its bounds
+func TestReplanBesidePins(t *testing.T) {
+	tasks := []Task{
+		{Todo: Todo{Id: "whole", Title: "whole", RequiredTime: 4}, Priority: 1},
+		{Todo: Todo{Id: "read", Title: "read", RequiredTime: 4}, Priority: 1, IsBreakable: true, MinChunk: 2},
+		{Todo: Todo{Id: "other", Title: "other", RequiredTime: 4}, Priority: 2, IsBreakable: true},
+	}
+	pins := map[Slot]TableCell{
+		{Period: 1, Block: 2}: {Type: "task", TodoId: "whole"},
+		{Period: 1, Block: 4}: {Type: "task", TodoId: "whole"},
+		{Period: 0, Block: 5}: {Type: "task", TodoId: "read"},
+	}
+
+	for _, strategy := range Strategies() {
+		t.Run(strategy.Name(), func(t *testing.T) {
+			p := NewPlanner(units.Hour, units.Day, tasks, nil, 2, 6)
+			p.SetStrategy(strategy)
+			p.SetOverflow(OverflowStrict)
+			if err := p.SetPinnedCells(pins); err != nil {
+				t.Fatal(err)
+			}
+			table, err := p.GenerateTable()
+			if err != nil {
+				t.Fatalf("GenerateTable() error = %v", err)
+			}
+
+			for slot, cell := range pins {
+				if got := table[slot.Period][slot.Block]; got.TodoId != cell.TodoId || !got.Pinned {
+					t.Errorf("slot (%d, %d) holds %+v, want pinned %q", slot.Period, slot.Block, got, cell.TodoId)
+				}
+			}
+			if got := p.taskBlocksIn("whole", 1); got != 4 {
+				t.Errorf("period 1 holds %d blocks of whole, want 4", got)
+			}
+			for _, task := range tasks {
+				if _, err := p.misplaced(task); err != nil {
+					t.Errorf("misplaced(%s) = %v", task.Id, err)
+				}
+			}
+		})
+	}
+}
+
+// TestReplanWithPinsOfItsOwnPlan pins random cells of generated tables and
+// generates them again. The pins always hold, a table that can't be
+// generated again around them can only fail for want of room.
+func TestReplanWithPinsOfItsOwnPlan(t *testing.T) {
+	random := rand.New(rand.NewSource(6))
+	replanned, failed := 0, 0
+	for n := range 300 {
+		periods, blocks := 2+random.Intn(3), 4+random.Intn(4)
+		var tasks []Task
+		for i := range 2 + random.Intn(4) {
+			task := Task{Todo: Todo{Id: fmt.Sprint("t", i), Title: fmt.Sprint("t", i), RequiredTime: 1 + random.Intn(6)}, Priority: 1 + random.Intn(3)}
+			if random.Intn(2) == 0 {
+				task.IsBreakable = true
+				task.MinChunk = random.Intn(3)
+			}
+			if random.Intn(4) == 0 {
+				deadline := 1 + random.Intn(periods)
+				task.Deadline = &deadline
+			}
+			if i > 0 && random.Intn(3) == 0 {
+				task.Prerequisites = []string{fmt.Sprint("t", random.Intn(i))}
+			}
+			tasks = append(tasks, task)
+		}
+		routines := []Routine{{Todo: Todo{Id: "r", Title: "r", RequiredTime: 1}, Position: "end", Every: random.Intn(3)}}
+		strategy := Strategies()[random.Intn(len(Strategies()))]
+
+		plan := func() *Planner {
+			p := NewPlanner(units.Hour, units.Day, tasks, routines, periods, blocks)
+			p.SetStrategy(strategy)
+			p.SetOverflow(OverflowStrict)
+			return p
+		}
+		table, err := plan().GenerateTable()
+		if err != nil {
+			continue
+		}
+
+		pins := make(map[Slot]TableCell)
+		for i, period := range table {
+			for block, cell := range period {
+				if (cell.Type == "task" || cell.Type == "routine") && random.Intn(3) == 0 {
+					pins[Slot{Period: i, Block: block}] = cell
+				}
+			}
+		}
+
+		p := plan()
+		if err := p.SetPinnedCells(pins); err != nil {
+			t.Fatalf("case %d: SetPinnedCells() error = %v", n, err)
+		}
+		table, err = p.GenerateTable()
+		var capacityErr *CapacityError
+		if errors.As(err, &capacityErr) {
+			failed++
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: GenerateTable() error = %v", n, err)
+		}
+		replanned++
+
+		for slot, cell := range pins {
+			if got := table[slot.Period][slot.Block]; got.TodoId != cell.TodoId || !got.Pinned {
+				t.Errorf("case %d: slot (%d, %d) holds %+v, want pinned %q", n, slot.Period, slot.Block, got, cell.TodoId)
+			}
+		}
+		for _, task := range tasks {
+			if _, err := p.misplaced(task); err != nil {
+				t.Errorf("case %d: %v", n, err)
+			}
+		}
+	}
+
+	// the strategies are heuristics, they can miss the room the first table had
+	if failed*10 > replanned {
+		t.Errorf("%d tables generated again, %d failed", replanned, failed)
+	}
+}
@@ -3,23 +3,49 @@ package planner
 import (
 	"fmt"
 	"math"
+	"planner-microservice/units"
 	"planner-microservice/utils"
-	"sort"
+	"slices"
+	"time"
 )
 
 type Planner struct {
-	build_unit  string
-	period_unit string
+	build_unit  units.Unit
+	period_unit units.Unit
 	tasks       []Task
 	routines    []Routine
 	n_periods   int
 	n_blocks    int
 	table       [][]TableCell
+
+	capacities       []int // blocks per period, n_blocks for the periods not listed
+	blocked_periods  map[int]bool
+	blocked_slots    map[Slot]bool
+	pinned_cells     map[Slot]TableCell
+	exceeded_periods []int
+	appended_periods []int         // periods added after the requested ones to make room
+	strategy         Strategy      // places the tasks, the default strategy when nil
+	time_budget      time.Duration // time strategies that search may take, DefaultTimeBudget when 0
+	deadline         time.Time     // searches stop by it whatever their budget, no limit when zero
+	improvement      *Improvement  // local search run on generated tables, none when nil
+	overflow         Overflow      // what happens to the todos that don't fit, OverflowGrow by default
+
+	unscheduled         []Unscheduled     // todos the last table doesn't hold all the blocks of, routines first
+	unscheduled_reasons map[string]string // why a strategy gave up on a task, by task id
 }
 
+// The largest requests the planner takes, past them a single plan or
+// estimate would take the service's time. PeriodsLimit bounds the requested
+// periods and the cycles and times of routines, RequiredTimeLimit the blocks
+// of a todo.
+const (
+	PeriodsLimit      = 10000
+	RequiredTimeLimit = 100000
+)
+
 func NewPlanner(
-	build_unit string,
-	period_unit string,
+	build_unit units.Unit,
+	period_unit units.Unit,
 	tasks []Task,
 	routines []Routine,
 	n_periods int,
@@ -42,11 +68,10 @@ func totalTime(tasks []Task, routines []Routine, periods int) int {
 		sum += task.RequiredTime
 	}
 
-	routineSum := 0
+	// routines only count in the periods they recur in
 	for _, routine := range routines {
-		routineSum += routine.RequiredTime
+		sum += routine.RequiredTime * routine.occurrences(periods)
 	}
-	sum += routineSum * periods
 
 	return sum
 }
@@ -59,40 +84,42 @@ func totalTasksTime(tasks []Task) int {
 	return sum
 }
 
+// mostPeriods returns the most periods a plan of tasks takes when every
+// period leaves them a block, for estimates made before periods are known.
+// No plan is requested with more than PeriodsLimit periods.
+func mostPeriods(tasks []Task) int {
+	latest := 0
+	for _, task := range tasks {
+		if task.HasEarliestStart() {
+			latest = max(latest, *task.EarliestStart)
+		}
+	}
+	return min(max(totalTasksTime(tasks)+latest, 1), PeriodsLimit)
+}
+
 // Least number of blocks required to complete all tasks and routines
 func LeastBlocks(tasks []Task, routines []Routine, periods int) int {
 	blocks := 1
 
-	// Find largest unbreakable task time
+	// Find largest unbreakable task time or smallest chunk of a breakable task
 	for _, task := range tasks {
-		if !task.IsBreakable && task.RequiredTime > blocks {
-			blocks = task.RequiredTime
+		if least, _ := chunkBounds(task, task.RequiredTime); least > blocks {
+			blocks = least
 		}
 	}
 
-	// Add routine times if any exist
-	for _, routine := range routines {
-		blocks += routine.RequiredTime
-	}
+	// Add the routine times of the period holding the most routines
+	blocks += routinesTimePerPeriod(routines, max(periods, mostPeriods(tasks)))
 
 	return blocks
 }
 
-func MaxBlocks(tasks []Task, routines []Routine, blocks_unit string) int {
-	var maxPossibleBlocks int
-
-	fmt.Println("blocks unit that reached max blocks funciton:", blocks_unit)
-
-	switch blocks_unit {
-	case "hour":
-		maxPossibleBlocks = 24
-	case "day":
-		maxPossibleBlocks = 7
-	default:
-		maxPossibleBlocks = 4
-	}
+// MaxBlocks returns the most blocks a period needs: the blocks of the
+// todos, at most as many blocks as fit in a period
+func MaxBlocks(tasks []Task, routines []Routine, block units.Unit, period units.Unit) int {
+	maxPossibleBlocks := block.In(period)
 
-	blocks := totalTime(tasks, routines, 1)
+	blocks := totalTasksTime(tasks) + routinesTimePerPeriod(routines, mostPeriods(tasks))
 
 	if blocks > maxPossibleBlocks {
 		return maxPossibleBlocks
@@ -101,25 +128,14 @@ func MaxBlocks(tasks []Task, routines []Routine, blocks_unit string) int {
 	return blocks
 }
 
-func IsValid(tasks []Task, routines []Routine, blocks_unit string) bool {
-	var maxPossibleBlocks int
-
-	switch blocks_unit {
-	case "hour":
-		maxPossibleBlocks = 24
-	case "day":
-		maxPossibleBlocks = 7
-	default:
-		maxPossibleBlocks = 4
-	}
-
+func IsValid(tasks []Task, routines []Routine, block units.Unit, period units.Unit) bool {
 	leastBlocks := LeastBlocks(tasks, routines, 1)
-	return leastBlocks <= maxPossibleBlocks
+	return leastBlocks <= block.In(period)
 }
 
 func NPeriodsFromBlocks(tasks []Task, routines []Routine, nBlocks int) int {
 	totalTasksTime := totalTasksTime(tasks)
-	routinesPerPeriod := totalTime([]Task{}, routines, 1)
+	routinesPerPeriod := routinesTimePerPeriod(routines, mostPeriods(tasks))
 	return utils.DeviseAndCeil(nBlocks-routinesPerPeriod, totalTasksTime)
 }
 
@@ -129,119 +145,223 @@ func NBlocksFromPeriods(tasks []Task, routines []Routine, nPeriods int) int {
 	return utils.DeviseAndCeil(nPeriods, totalTimeValue)
 }
 
+// NPeriodsFromReleases returns the periods needed when tasks can't start
+// before their earliest period, 0 when no task has one
+func NPeriodsFromReleases(tasks []Task, routines []Routine, nBlocks int) int {
+	freeBlocks := nBlocks - routinesTimePerPeriod(routines, mostPeriods(tasks))
+	if freeBlocks < 1 {
+		return 0
+	}
+
+	periods := 0
+	for _, task := range tasks {
+		if !task.HasEarliestStart() {
+			continue
+		}
+
+		// everything released with or after this task comes after its start
+		releasedTime := 0
+		for _, other := range tasks {
+			if other.HasEarliestStart() && *other.EarliestStart >= *task.EarliestStart {
+				releasedTime += other.RequiredTime
+			}
+		}
+
+		if n := *task.EarliestStart + utils.DeviseAndCeil(freeBlocks, releasedTime); n > periods {
+			periods = n
+		}
+	}
+
+	return periods
+}
+
 func LeastPeriods(tasks []Task, routines []Routine, maxBlocks int) int {
-	return NPeriodsFromBlocks(tasks, routines, maxBlocks)
+	return max(NPeriodsFromBlocks(tasks, routines, maxBlocks), NPeriodsFromReleases(tasks, routines, maxBlocks))
 }
 
 func MaxPeriods(tasks []Task, routines []Routine, leastBlocks int) int {
-	return NPeriodsFromBlocks(tasks, routines, leastBlocks)
+	return max(NPeriodsFromBlocks(tasks, routines, leastBlocks), NPeriodsFromReleases(tasks, routines, leastBlocks))
 }
 
 func (p *Planner) TotalTimeInPeriodUnit() string {
-	timeUnitsValue := map[string]int{
-		"minute": 1,
-		"hour":   60,
-		"day":    1440,
-		"week":   10080,
-		"month":  43200,
-	}
-
-	buildUnitValue := timeUnitsValue[p.build_unit]
-	periodUnitValue := timeUnitsValue[p.period_unit]
-
-	totalTimeInMinutes := totalTime(p.tasks, p.routines, p.n_periods) * buildUnitValue
-	totalTime := math.Round(float64(totalTimeInMinutes) / float64(periodUnitValue))
+	totalTimeInMinutes := totalTime(p.tasks, p.routines, p.n_periods) * p.build_unit.Minutes()
+	totalTime := math.Round(float64(totalTimeInMinutes) / float64(p.period_unit.Minutes()))
 
+	// "3 90 minutes" wouldn't read as three periods
+	if !p.period_unit.IsCalendar() {
+		return fmt.Sprintf("%.0f periods of %s", totalTime, p.period_unit)
+	}
 	return fmt.Sprintf("%.0f %s", totalTime, p.period_unit)
 }
 
+// compareTasks orders unbreakable tasks before breakable ones and shorter
+// breakable tasks first, it's a strict ordering so sorting it stably keeps
+// the request order of equal tasks
 func compareTasks(a, b Task) bool {
-	if !a.IsBreakable {
-		return true
+	if a.IsBreakable != b.IsBreakable {
+		return !a.IsBreakable
 	}
-	if !b.IsBreakable {
+	if !a.IsBreakable {
 		return false
 	}
 	return a.RequiredTime < b.RequiredTime
 }
 
+// ValidatePlanParameters reports whether a plan can be generated,
+// Diagnose explains what fails when it can't. Best-effort plans can be
+// generated whatever their capacity, strict plans that pass can still fail
+// in GenerateTable with a CapacityError when the todos don't pack.
 func (p *Planner) ValidatePlanParameters() bool {
-	// to validate the plan parameters:
-	// - calculate the total time of tasks
-	// - calculate the total time of routines per period
-	// - subtract the total time of routines per period from number of blocks
-	// - if number of blocks goes less than 1 then fail immediately
-	// - divide the total time of tasks by the remaining blocks and ceil the result
-	// - if the result is less than the number of periods then fail (return false)
-
-	totalTasksTime := 0
+	return len(p.infeasibilities()) == 0
+}
+
+func (p *Planner) isPlacesAvailable(freq int, index int) bool {
+	if len(p.table[index])+freq > p.capacity(index) {
+		return false
+	}
+	return true
+}
+
+// window returns the first and last period indexes a task can be placed in
+func (p *Planner) window(task Task) (int, int) {
+	// the rest of an unbreakable task goes next to its pinned cells
+	if period, ok := p.pinnedPeriod(task.Id); ok && !task.IsBreakable {
+		return period, period
+	}
+
+	first := p.prerequisitesEnd(task)
+	if task.HasEarliestStart() && *task.EarliestStart > first {
+		first = *task.EarliestStart
+	}
+	last := p.n_periods - 1
+	if deadline, ok := p.deadlineOf(task); ok && deadline < last {
+		last = deadline
+	}
+	return first, last
+}
+
+func (p *Planner) findTask(id string) (Task, bool) {
 	for _, task := range p.tasks {
-		totalTasksTime += task.RequiredTime
+		if task.Id == id {
+			return task, true
+		}
 	}
+	return Task{}, false
+}
 
-	totalRoutinesTimePerPeriod := 0
+func (p *Planner) findRoutine(id string) (Routine, bool) {
 	for _, routine := range p.routines {
-		totalRoutinesTimePerPeriod += routine.RequiredTime
+		if routine.Id == id {
+			return routine, true
+		}
 	}
+	return Routine{}, false
+}
 
-	remainingBlocks := p.n_blocks - totalRoutinesTimePerPeriod
-	if remainingBlocks < 1 {
+// allowedIn reports whether a placed cell may be moved from the period at
+// from to the period at index
+func (p *Planner) allowedIn(cell TableCell, from int, index int) bool {
+	// routines belong to every period, moving one would duplicate it
+	if cell.Type != "task" || cell.Pinned {
 		return false
 	}
 
-	periodsNeeded := int(math.Ceil(float64(totalTasksTime) / float64(remainingBlocks)))
-	if periodsNeeded > p.n_periods {
+	// the cells of an unbreakable task stay together
+	task, ok := p.findTask(cell.TodoId)
+	if !ok || !task.IsBreakable {
 		return false
 	}
 
-	// passed then you're good to go ...
-	return true
-}
+	// a prerequisite can't move next to or after the tasks waiting for it
+	for _, dependent := range p.dependentsOf(task.Id) {
+		if first := p.firstPeriodOf(dependent.Id); first != -1 && index >= first {
+			return false
+		}
+	}
 
-func (p *Planner) isPlacesAvailable(freq int, index int) bool {
-	if len(p.table[index])+freq > p.n_blocks {
+	start, end := p.window(task)
+	if index < start || index > end {
 		return false
 	}
-	return true
-}
 
-func (p *Planner) addRoutine(routine Routine) {
-	cells := make([]TableCell, routine.RequiredTime)
-	for i := range cells {
-		cells[i] = TableCell{
-			Type:   "routine",
-			TodoId: routine.Id,
-		}
+	// both periods keep whole chunks of the task
+	least, most := chunkBounds(task, p.wholeSize(task))
+	if left := p.taskBlocksIn(task.Id, from) - 1; left > 0 && left < least {
+		return false
+	}
+	if blocks := p.taskBlocksIn(task.Id, index) + 1; blocks < least || blocks > most {
+		return false
 	}
 
+	// the blocks of every task of the period stay together
+	return p.fitsWhole(task.Id, index, 1)
+}
+
+func (p *Planner) addRoutine(routine Routine) {
+	missing := Unscheduled{Type: "routine", TodoId: routine.Id}
 	for i := range p.n_periods {
 		if p.table[i] == nil {
 			p.table[i] = make([]TableCell, 0)
 		}
+
+		if !p.occursIn(routine, i) {
+			continue
+		}
+
+		// pinned cells of the routine already count for the period
+		cells := make([]TableCell, p.missingRoutineTime(routine, i))
+		for j := range cells {
+			cells[j] = TableCell{
+				Type:   "routine",
+				TodoId: routine.Id,
+			}
+		}
+
+		// skip the periods that can't hold the routine
+		if !p.isPlacesAvailable(len(cells), i) {
+			if !slices.Contains(p.exceeded_periods, i) {
+				p.exceeded_periods = append(p.exceeded_periods, i)
+			}
+			missing.Missing += len(cells)
+			missing.Periods = append(missing.Periods, i)
+			continue
+		}
 		p.table[i] = append(cells, p.table[i]...)
 	}
+
+	if missing.Missing > 0 {
+		missing.Reason = fmt.Sprintf("routine %q doesn't fit in %d of its periods", routine.Title, len(missing.Periods))
+		p.unscheduled = append(p.unscheduled, missing)
+	}
 }
 
-func (p *Planner) generateAvailability(tbf int) (int, error) {
+func (p *Planner) generateAvailability(task Task, tbf int) (int, error) {
+	start, end := p.window(task)
+
 	totalAvailablePlaces := 0
-	for _, period := range p.table {
-		totalAvailablePlaces += p.n_blocks - len(period)
+	for i, period := range p.table {
+		totalAvailablePlaces += p.capacity(i) - len(period)
 	}
 
-	if tbf <= totalAvailablePlaces {
-		// Find shortest period
-		shortestIndex := 0
-		for i := 1; i < len(p.table); i++ {
-			if len(p.table[i]) < len(p.table[shortestIndex]) {
-				shortestIndex = i
-			}
+	// Find the period with the most room the task can be placed in,
+	// leaving out the periods already holding its largest chunk
+	_, most := chunkBounds(task, p.wholeSize(task))
+	shortestIndex := -1
+	for i := start; i <= end; i++ {
+		if p.taskBlocksIn(task.Id, i)+tbf > min(most, p.largestRun(i, task.Id)) {
+			continue
+		}
+		if shortestIndex == -1 || p.capacity(i)-len(p.table[i]) > p.capacity(shortestIndex)-len(p.table[shortestIndex]) {
+			shortestIndex = i
 		}
+	}
 
-		if len(p.table[shortestIndex]) == 0 {
+	if tbf <= totalAvailablePlaces && shortestIndex != -1 {
+		if len(p.table[shortestIndex]) == 0 && p.capacity(shortestIndex) > 0 && p.fitsWhole(task.Id, shortestIndex, tbf) {
 			return shortestIndex, nil
 		}
 
-		numItemsToMove := tbf - (p.n_blocks - len(p.table[shortestIndex]))
+		numItemsToMove := tbf - (p.capacity(shortestIndex) - len(p.table[shortestIndex]))
 
 	outer:
 		for i := 0; i < numItemsToMove; i++ {
@@ -255,144 +375,83 @@ func (p *Planner) generateAvailability(tbf int) (int, error) {
 			}
 
 			for periodIndex, period := range p.table {
-				if len(period) < p.n_blocks && periodIndex != shortestIndex {
+				if len(period) < p.capacity(periodIndex) && periodIndex != shortestIndex && p.allowedIn(item, shortestIndex, periodIndex) {
 					p.table[periodIndex] = append(p.table[periodIndex], item)
 					p.table[shortestIndex] = p.table[shortestIndex][:len(p.table[shortestIndex])-1]
 					continue outer
 				}
 			}
+
+			// the last item can't go anywhere else
+			break
 		}
 
-		return shortestIndex, nil
-	} else {
-		p.table = append(p.table, make([]TableCell, 0, p.n_blocks))
-		p.n_periods++
-		return len(p.table) - 1, nil
+		if p.isPlacesAvailable(tbf, shortestIndex) && p.fitsWhole(task.Id, shortestIndex, tbf) {
+			return shortestIndex, nil
+		}
 	}
-}
 
-func (p *Planner) GenerateTable() [][]TableCell {
-	// Add routines
-	for _, routine := range p.routines {
-		p.addRoutine(routine)
+	// a new period would come after the deadline
+	if deadline, ok := p.deadlineOf(task); ok {
+		return 0, fmt.Errorf("task %q can't be placed before its deadline (period %d)", task.Title, deadline+1)
 	}
 
-	// Add tasks to priority queues
-	var highPriority, normalPriority, lowPriority []Task
-
-	// Make deep clone of tasks
-	tasksClone := make([]Task, len(p.tasks))
-	for i, task := range p.tasks {
-		taskCopy := task
-		tasksClone[i] = taskCopy
-	}
-
-	// split tasks by priority
-	for _, task := range tasksClone {
-		switch task.Priority {
-		case 3:
-			highPriority = append(highPriority, task)
-		case 2:
-			normalPriority = append(normalPriority, task)
-		case 1:
-			lowPriority = append(lowPriority, task)
-		}
+	// strict and best-effort plans keep the requested periods
+	if !p.canGrow() {
+		return 0, fmt.Errorf("task %q doesn't fit in the %d periods of the plan", task.Title, p.n_periods)
 	}
 
-	addTaskToResultArray := func(task Task) {
-		// Define default taskBlocksFrequency & handle undivisible
-		var taskBlocksFrequency int
-		if !task.IsBreakable {
-			taskBlocksFrequency = task.RequiredTime
-		} else {
-			taskBlocksFrequency = utils.DeviseAndCeil(task.RequiredTime, p.n_periods)
-		}
-
-		changed := false
-		var remainingPeriods int
+	p.table = append(p.table, make([]TableCell, 0, p.n_blocks))
+	p.n_periods++
+	p.appended_periods = append(p.appended_periods, len(p.table)-1)
+	return len(p.table) - 1, nil
+}
 
-		pushToResultArray := func(index int) {
-			for x := 0; x < taskBlocksFrequency; x++ {
-				if task.RequiredTime == 0 {
-					break
-				}
-				p.table[index] = append(p.table[index], TableCell{
-					Type:   "task",
-					TodoId: task.Id,
-				})
-				task.RequiredTime--
-			}
+func (p *Planner) GenerateTable() ([][]TableCell, error) {
+	// Add pinned cells, they are put back in their slots by layoutTable
+	for i := range p.n_periods {
+		for _, slot := range p.pinnedSlotsIn(i) {
+			p.table[i] = append(p.table[i], p.pinned_cells[slot])
 		}
+	}
 
-		var pusher func()
-		pusher = func() {
-			for i := 0; i < p.n_periods; i++ {
-				if p.table[i] == nil {
-					p.table[i] = make([]TableCell, 0)
-				}
-
-				if len(p.table[i]) == p.n_blocks {
-					continue
-				}
+	// Add routines
+	p.exceeded_periods = nil
+	p.appended_periods = nil
+	p.unscheduled = nil
+	p.unscheduled_reasons = nil
+	for _, routine := range p.routines {
+		p.addRoutine(routine)
+	}
 
-				if !task.IsBreakable {
-					if p.isPlacesAvailable(taskBlocksFrequency, i) {
-						pushToResultArray(i)
-					}
-					continue
-				}
+	// Tasks without their pinned blocks
+	tasks := make([]Task, len(p.tasks))
+	for i, task := range p.tasks {
+		tasks[i] = task
+		tasks[i].RequiredTime = p.remainingTime(task)
+	}
 
-				if changed {
-					remainingPeriods = p.n_periods - (i + 1)
-					taskBlocksFrequency = utils.DeviseAndCeil(task.RequiredTime, remainingPeriods)
-					changed = false
-				}
+	if err := p.Strategy().placeTasks(p, tasks); err != nil {
+		return nil, err
+	}
 
-				if p.isPlacesAvailable(taskBlocksFrequency, i) {
-					pushToResultArray(i)
-					if task.RequiredTime == 0 {
-						return
-					}
-				} else {
-					for !p.isPlacesAvailable(taskBlocksFrequency, i) {
-						taskBlocksFrequency--
-						changed = true
-					}
-					pushToResultArray(i)
-					if task.RequiredTime == 0 {
-						return
-					}
-				}
-			}
+	p.layoutTable()
 
-			if task.RequiredTime > 0 {
-				if !task.IsBreakable {
-					avIndex, _ := p.generateAvailability(taskBlocksFrequency)
-					pushToResultArray(avIndex)
-				} else {
-					taskBlocksFrequency = utils.DeviseAndCeil(task.RequiredTime, p.n_periods)
-					pusher()
-				}
-			}
-		}
+	if p.improvement != nil {
+		p.table = p.Improve(p.table, *p.improvement)
+	}
 
-		pusher()
+	if err := p.checkPlacement(); err != nil {
+		return nil, err
 	}
 
-	// Process all priority levels in order
-	priorities := [][]Task{highPriority, normalPriority, lowPriority}
-	for _, priorityTasks := range priorities {
-		if len(priorityTasks) > 0 {
-			sort.Slice(priorityTasks, func(i, j int) bool {
-				return compareTasks(priorityTasks[i], priorityTasks[j])
-			})
-			for _, task := range priorityTasks {
-				addTaskToResultArray(task)
-			}
-		}
+	// Report the tasks that didn't fit instead of dropping them
+	p.unscheduled = append(p.unscheduled, p.findUnscheduled()...)
+	if err := p.capacityError(); err != nil {
+		return nil, err
 	}
 
-	return p.table
+	return p.table, nil
 }
 
 func (p *Planner) LogResultArray() {
@@ -412,6 +471,10 @@ func (p *Planner) LogResultArray() {
 						fmt.Printf("%-15s", fmt.Sprintf("Routine-[%s]   ", routine.Title))
 					}
 				}
+			case "blocked":
+				fmt.Printf("%-15s", "Blocked   ")
+			case "free":
+				fmt.Printf("%-15s", "Free   ")
 			}
 		}
 		fmt.Println()
@@ -454,8 +517,8 @@ func TestSamplePlanner() {
 
 	// Create new planner
 	planner := NewPlanner(
-		"hour",
-		"day",
+		units.Hour,
+		units.Day,
 		tasks,
 		routines,
 		5, // n_periods
@@ -463,10 +526,10 @@ func TestSamplePlanner() {
 	)
 
 	// Generate and log table
-	table := planner.GenerateTable()
+	table, err := planner.GenerateTable()
 
 	if table == nil {
-		print("error while creating plan")
+		print("error while creating plan: ", err.Error())
 	}
 
 	planner.LogResultArray()
@@ -0,0 +1,48 @@
+package planner
+
+import (
+	"planner-microservice/units"
+	"testing"
+)
+
+func TestEarliestStart(t *testing.T) {
+	start := 2
+	tasks := []Task{
+		{Todo: Todo{Id: "lab", Title: "lab", RequiredTime: 4}, Priority: 3, IsBreakable: true, EarliestStart: &start},
+		{Todo: Todo{Id: "essay", Title: "essay", RequiredTime: 2}, Priority: 1},
+	}
+	routines := []Routine{{Todo: Todo{Id: "walk", Title: "walk", RequiredTime: 1}, Position: "start"}}
+
+	// the estimates count the periods before the lab is released
+	if got := LeastPeriods(tasks, routines, 3); got != 4 {
+		t.Fatalf("LeastPeriods() = %d, want 4", got)
+	}
+	if got := NPeriodsFromReleases(tasks[1:], routines, 3); got != 0 {
+		t.Errorf("NPeriodsFromReleases() without releases = %d, want 0", got)
+	}
+
+	// a period less leaves the lab the 2 free blocks of the last one
+	infeasibilities := NewPlanner(units.Hour, units.Day, tasks, routines, 3, 3).Diagnose()
+	if len(infeasibilities) != 1 || infeasibilities[0].Constraint != TaskWindowOverloaded || infeasibilities[0].Shortfall != 2 {
+		t.Errorf("Diagnose() = %+v, want the window of lab overloaded by 2 blocks", infeasibilities)
+	}
+
+	for _, strategy := range Strategies() {
+		t.Run(strategy.Name(), func(t *testing.T) {
+			p := NewPlanner(units.Hour, units.Day, tasks, routines, 4, 3)
+			p.SetStrategy(strategy)
+			p.SetOverflow(OverflowStrict)
+			if _, err := p.GenerateTable(); err != nil {
+				t.Fatalf("GenerateTable() error = %v", err)
+			}
+
+			slots := p.slotsOf("lab", false)
+			if len(slots) != 4 {
+				t.Fatalf("lab has %d blocks, want 4", len(slots))
+			}
+			if slots[0].Period < start {
+				t.Errorf("lab starts in period %d, before its earliest start %d", slots[0].Period, start)
+			}
+		})
+	}
+}
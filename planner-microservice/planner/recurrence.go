@@ -0,0 +1,144 @@
+package planner
+
+import "slices"
+
+// recursIn reports whether a routine's recurrence includes the period at
+// index, the number of times it's in the plan aside
+func (r Routine) recursIn(index int) bool {
+	if r.Every <= 1 && len(r.Offsets) == 0 {
+		return true
+	}
+
+	cycle := max(r.Every, 1)
+	if len(r.Offsets) == 0 {
+		return index%cycle == 0
+	}
+	return slices.Contains(r.Offsets, index%cycle)
+}
+
+// occurrences returns the number of periods a routine is in when the plan has periods
+func (r Routine) occurrences(periods int) int {
+	count := 0
+	for i := range periods {
+		if r.recursIn(i) {
+			count++
+		}
+	}
+	if r.Times > 0 {
+		return min(count, r.Times)
+	}
+	return count
+}
+
+// routinesTimePerPeriod returns the most blocks routines can take in a
+// single period of a plan with periods periods
+func routinesTimePerPeriod(routines []Routine, periods int) int {
+	// the recurrences repeat every least common multiple of their cycles,
+	// the periods of the plan past it hold the same routines again
+	horizon := 1
+	for _, routine := range routines {
+		if routine.Every > 1 && horizon < periods {
+			horizon = lcm(horizon, routine.Every)
+		}
+	}
+	horizon = min(horizon, max(periods, 1))
+
+	// routines of every period load them all alike, the others are added
+	// to the periods they recur in
+	every := 0
+	load := make([]int, horizon)
+	for _, routine := range routines {
+		if routine.Every <= 1 && len(routine.Offsets) == 0 {
+			every += routine.RequiredTime
+			continue
+		}
+		cycle := max(routine.Every, 1)
+		offsets := slices.Compact(slices.Sorted(slices.Values(routine.Offsets)))
+		offsets = slices.DeleteFunc(offsets, func(offset int) bool {
+			return offset < 0 || offset >= cycle
+		})
+		if len(routine.Offsets) == 0 {
+			offsets = []int{0}
+		}
+		for start := 0; start < horizon; start += cycle {
+			for _, offset := range offsets {
+				if start+offset < horizon {
+					load[start+offset] += routine.RequiredTime
+				}
+			}
+		}
+	}
+	return every + slices.Max(load)
+}
+
+func lcm(a int, b int) int {
+	x, y := a, b
+	for y != 0 {
+		x, y = y, x%y
+	}
+	return a / x * b
+}
+
+// occursIn reports whether a routine is placed in the period at index
+func (p *Planner) occursIn(routine Routine, index int) bool {
+	if !routine.recursIn(index) {
+		return false
+	}
+	if routine.Times == 0 {
+		return true
+	}
+	return slices.Contains(p.routinePeriods(routine), index)
+}
+
+// routinePeriods returns the periods a routine with a number of times is
+// placed in: the plan's periods it can recur in are split into that many
+// ranges, and the period with the most room of each range is chosen
+func (p *Planner) routinePeriods(routine Routine) []int {
+	var candidates []int
+	for i := range p.n_periods {
+		if routine.recursIn(i) && p.capacity(i) > 0 {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) <= routine.Times {
+		return candidates
+	}
+
+	// room left after pinned cells and the routines in every period
+	room := func(index int) int {
+		used := len(p.pinnedSlotsIn(index))
+		for _, other := range p.routines {
+			if other.Times == 0 && other.recursIn(index) {
+				used += other.RequiredTime
+			}
+		}
+		return p.capacity(index) - used
+	}
+
+	periods := make([]int, 0, routine.Times)
+	for j := range routine.Times {
+		from := j * len(candidates) / routine.Times
+		to := (j + 1) * len(candidates) / routine.Times
+
+		best := candidates[from]
+		for _, index := range candidates[from+1 : to] {
+			if room(index) > room(best) {
+				best = index
+			}
+		}
+		periods = append(periods, best)
+	}
+	return periods
+}
+
+// recurrencePeriods returns the number of periods of the plan a routine can
+// recur in, a routine with a number of times needs at least that many
+func (p *Planner) recurrencePeriods(routine Routine) int {
+	count := 0
+	for i := range p.n_periods {
+		if routine.recursIn(i) && p.capacity(i) > 0 {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,66 @@
+package planner
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRoutinesTimePerPeriod(t *testing.T) {
+	daily := Routine{Todo: Todo{Id: "daily", RequiredTime: 1}}
+	weekly := Routine{Todo: Todo{Id: "weekly", RequiredTime: 2}, Every: 7, Offsets: []int{3}}
+	// every 41 and every 43 with an offset of 26 first recur together in period 1230
+	first := Routine{Todo: Todo{Id: "first", RequiredTime: 2}, Every: 41}
+	second := Routine{Todo: Todo{Id: "second", RequiredTime: 3}, Every: 43, Offsets: []int{26}}
+
+	tests := []struct {
+		name     string
+		routines []Routine
+		periods  int
+		want     int
+	}{
+		{name: "no routines", periods: 5, want: 0},
+		{name: "every period", routines: []Routine{daily}, periods: 1, want: 1},
+		{name: "before the weekly one", routines: []Routine{daily, weekly}, periods: 3, want: 1},
+		{name: "with the weekly one", routines: []Routine{daily, weekly}, periods: 4, want: 3},
+		{name: "apart", routines: []Routine{first, second}, periods: 1230, want: 3},
+		{name: "together past a thousand periods", routines: []Routine{first, second}, periods: 1231, want: 5},
+		{name: "past the cycles", routines: []Routine{first, second}, periods: 100000, want: 5},
+		{name: "repeated offsets", routines: []Routine{{Todo: Todo{Id: "twice", RequiredTime: 2}, Every: 3, Offsets: []int{1, 1}}}, periods: 3, want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := routinesTimePerPeriod(tt.routines, tt.periods); got != tt.want {
+				t.Errorf("routinesTimePerPeriod() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEstimatesLookAtEveryPeriod(t *testing.T) {
+	tasks := []Task{{Todo: Todo{Id: "essay", RequiredTime: 1300}, IsBreakable: true}}
+	routines := []Routine{
+		{Todo: Todo{Id: "first", RequiredTime: 2}, Every: 41},
+		{Todo: Todo{Id: "second", RequiredTime: 3}, Every: 43, Offsets: []int{26}},
+	}
+	if got := LeastBlocks(tasks, routines, 1); got != 6 {
+		t.Errorf("LeastBlocks() = %d, want 6", got)
+	}
+}
+
+func TestEstimatesOfLongTasks(t *testing.T) {
+	// the routines are looked at over the periods of the longest plan, not
+	// over a period per block of the tasks
+	tasks := []Task{{Todo: Todo{Id: "essay", RequiredTime: 300000000}, IsBreakable: true}}
+	routines := []Routine{{Todo: Todo{Id: "gym", RequiredTime: 2}, Every: 300000000, Offsets: []int{0, 0}}}
+	if got := mostPeriods(tasks); got != PeriodsLimit {
+		t.Errorf("mostPeriods() = %d, want %d", got, PeriodsLimit)
+	}
+
+	start := time.Now()
+	if got := LeastBlocks(tasks, routines, 1); got != 3 {
+		t.Errorf("LeastBlocks() = %d, want 3", got)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("LeastBlocks() took %s", elapsed)
+	}
+}
@@ -0,0 +1,323 @@
+package planner
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"time"
+)
+
+// DefaultTimeBudget is how long the solver searches when the plan doesn't say
+const DefaultTimeBudget = time.Second
+
+// solver searches for the placement of least cost within the requested
+// periods by branch and bound. The cost adds up, for every block, its period
+// weighted by the task's priority, a fixed cost per chunk and the squared
+// load of the periods, so urgent work goes first, tasks stay in few chunks
+// and the load is even. The best placement found when the time budget runs
+// out is kept, the greedy strategy places the tasks when none was found.
+type solver struct{}
+
+func (solver) Name() string {
+	return "solver"
+}
+
+func (solver) Description() string {
+	return "Searches for the best placement within the time budget without adding periods, falls back to greedy"
+}
+
+func (solver) placeTasks(p *Planner, tasks []Task) error {
+	// the hardest tasks to fit are searched first, so dead ends show early
+	order := priorityOrder(p, tasks)
+	sort.SliceStable(order, func(i, j int) bool {
+		if order[i].IsBreakable != order[j].IsBreakable {
+			return !order[i].IsBreakable
+		}
+		return order[i].RequiredTime > order[j].RequiredTime
+	})
+
+	s := newSolverSearch(p, dependencyOrder(order), p.searchDeadline(p.TimeBudget()))
+	if best := s.run(); best != nil {
+		for k, task := range s.tasks {
+			for i, blocks := range best[k] {
+				p.pushBlocks(task, i, blocks)
+			}
+		}
+		return nil
+	}
+	return greedy{}.placeTasks(p, tasks)
+}
+
+// SetTimeBudget bounds the time strategies that search may take, the default budget is used when 0
+func (p *Planner) SetTimeBudget(budget time.Duration) {
+	p.time_budget = budget
+}
+
+// TimeBudget returns the time strategies that search may take
+func (p *Planner) TimeBudget() time.Duration {
+	if p.time_budget <= 0 {
+		return DefaultTimeBudget
+	}
+	return p.time_budget
+}
+
+// SetDeadline stops the searches of the solver and of the improvement pass
+// by deadline whatever their budget, the zero time sets no limit
+func (p *Planner) SetDeadline(deadline time.Time) {
+	p.deadline = deadline
+}
+
+// searchDeadline returns when a search that may take budget has to stop
+func (p *Planner) searchDeadline(budget time.Duration) time.Time {
+	deadline := time.Now().Add(budget)
+	if !p.deadline.IsZero() && p.deadline.Before(deadline) {
+		return p.deadline
+	}
+	return deadline
+}
+
+// solverSearch holds the state of a search, alloc[k][i] is the number of
+// blocks of the k-th task in the i-th period
+type solverSearch struct {
+	p           *Planner
+	tasks       []Task
+	weight      []int
+	least, most []int
+	start, end  []int
+	prereqs     [][]int // indexes of the prerequisites of every task
+	pinned      [][]int // cells of every task already in every period
+	bound       []int   // least cost of the tasks from every index on
+
+	capacity []int
+	runs     []int // largest free run of every period
+	used     []int
+	alloc    [][]int
+	cost     int
+
+	best     [][]int
+	bestCost int
+	chunk    int // cost of a chunk
+
+	deadline time.Time
+	nodes    int
+	timedOut bool
+}
+
+func newSolverSearch(p *Planner, tasks []Task, deadline time.Time) *solverSearch {
+	n := len(p.table)
+	s := &solverSearch{
+		p:        p,
+		weight:   make([]int, 0, len(tasks)),
+		capacity: make([]int, n),
+		runs:     make([]int, n),
+		used:     make([]int, n),
+		bestCost: math.MaxInt,
+		chunk:    max(n, 1),
+		deadline: deadline,
+	}
+	for i := range n {
+		s.capacity[i] = p.capacity(i)
+		s.runs[i] = p.largestRun(i, "")
+		s.used[i] = len(p.table[i])
+	}
+
+	index := make(map[string]int)
+	for _, task := range tasks {
+		// fully pinned tasks have nothing left to place
+		if task.RequiredTime == 0 {
+			continue
+		}
+		index[task.Id] = len(s.tasks)
+		s.tasks = append(s.tasks, task)
+	}
+
+	for _, task := range s.tasks {
+		least, most := chunkBounds(task, p.wholeSize(task))
+		start, end := p.window(task)
+		s.weight = append(s.weight, max(task.Priority, 1))
+		s.least = append(s.least, least)
+		s.most = append(s.most, most)
+		s.start = append(s.start, start)
+		s.end = append(s.end, min(end, n-1))
+
+		var prereqs []int
+		for _, id := range task.Prerequisites {
+			if j, ok := index[id]; ok {
+				prereqs = append(prereqs, j)
+			}
+		}
+		s.prereqs = append(s.prereqs, prereqs)
+
+		pinned := make([]int, n)
+		for i := range n {
+			pinned[i] = p.taskBlocksIn(task.Id, i)
+		}
+		s.pinned = append(s.pinned, pinned)
+		s.alloc = append(s.alloc, make([]int, n))
+	}
+
+	// every task costs at least its blocks in its first period and a chunk
+	s.bound = make([]int, len(s.tasks)+1)
+	for k := len(s.tasks) - 1; k >= 0; k-- {
+		s.bound[k] = s.bound[k+1] + s.weight[k]*max(s.start[k], 0)*s.tasks[k].RequiredTime + s.chunk
+	}
+	return s
+}
+
+// run searches until every placement is explored or the time budget runs out,
+// it returns the best placement found or nil
+func (s *solverSearch) run() [][]int {
+	// the tasks can't fit without adding periods
+	required, free := 0, 0
+	for _, task := range s.tasks {
+		required += task.RequiredTime
+	}
+	for i := range s.capacity {
+		free += max(s.capacity[i]-s.used[i], 0)
+	}
+	if required > free {
+		return nil
+	}
+
+	s.enter(0)
+	return s.best
+}
+
+// enter starts placing the k-th task, after the last period of its prerequisites
+func (s *solverSearch) enter(k int) {
+	if k == len(s.tasks) {
+		if s.cost < s.bestCost {
+			s.bestCost = s.cost
+			s.best = make([][]int, len(s.alloc))
+			for j := range s.alloc {
+				s.best[j] = append([]int{}, s.alloc[j]...)
+			}
+		}
+		return
+	}
+
+	first := max(s.start[k], 0)
+	for _, j := range s.prereqs[k] {
+		for i := len(s.alloc[j]) - 1; i > first; i-- {
+			if s.alloc[j][i] > 0 {
+				first = i
+				break
+			}
+		}
+	}
+	s.place(k, first, s.tasks[k].RequiredTime)
+}
+
+// place decides how many of the remaining blocks of the k-th task go in the i-th period
+func (s *solverSearch) place(k int, i int, remaining int) {
+	if s.timedOut {
+		return
+	}
+	if s.nodes++; s.nodes%1024 == 0 && time.Now().After(s.deadline) {
+		s.timedOut = true
+		return
+	}
+
+	if remaining == 0 {
+		s.enter(k + 1)
+		return
+	}
+	if i > s.end[k] || s.cost+s.weight[k]*i*remaining+s.bound[k+1] >= s.bestCost {
+		return
+	}
+
+	// the periods left in the window have to hold what remains
+	room := 0
+	for j := i; j <= s.end[k]; j++ {
+		room += s.room(k, j)
+	}
+	if room < remaining {
+		return
+	}
+
+	for _, blocks := range s.options(k, i, remaining) {
+		if blocks > 0 {
+			s.add(k, i, blocks)
+			s.place(k, i+1, remaining-blocks)
+			s.add(k, i, -blocks)
+		} else {
+			s.place(k, i+1, remaining)
+		}
+	}
+}
+
+// room returns how many blocks of the k-th task the i-th period can still take
+func (s *solverSearch) room(k int, i int) int {
+	run := s.runs[i]
+	if s.pinned[k][i] > 0 {
+		run = s.p.largestRun(i, s.tasks[k].Id)
+	}
+	room := min(s.capacity[i]-s.used[i], s.most[k]-s.pinned[k][i], run-s.pinned[k][i])
+	if !s.tasks[k].IsBreakable && room < s.tasks[k].RequiredTime {
+		return 0
+	}
+	return max(room, 0)
+}
+
+// options lists the numbers of blocks of the k-th task the i-th period can
+// hold, the even share of the remaining periods first and none last
+func (s *solverSearch) options(k int, i int, remaining int) []int {
+	room := min(s.room(k, i), remaining)
+	if !s.tasks[k].IsBreakable {
+		if room >= remaining && s.fits(k, i, remaining) {
+			return []int{remaining, 0}
+		}
+		return []int{0}
+	}
+
+	least := max(s.least[k]-s.pinned[k][i], 1)
+	if room < least {
+		return []int{0}
+	}
+
+	periods := s.end[k] - i + 1
+	share := min(max((remaining+periods-1)/periods, least), room)
+	options := []int{share}
+	for blocks := share + 1; blocks <= room; blocks++ {
+		options = append(options, blocks)
+	}
+	for blocks := share - 1; blocks >= least; blocks-- {
+		options = append(options, blocks)
+	}
+	options = slices.DeleteFunc(options, func(blocks int) bool {
+		return !s.fits(k, i, blocks)
+	})
+	return append(options, 0)
+}
+
+// fits reports whether the i-th period keeps the blocks of every task
+// together once it holds blocks more of the k-th task
+func (s *solverSearch) fits(k int, i int, blocks int) bool {
+	period := slices.Clone(s.p.table[i])
+	for j, task := range s.tasks {
+		count := s.alloc[j][i]
+		if j == k {
+			count += blocks
+		}
+		for range count {
+			period = append(period, TableCell{
+				Type:   "task",
+				TodoId: task.Id,
+			})
+		}
+	}
+	return s.p.keepsTogether(i, period)
+}
+
+// add places blocks of the k-th task in the i-th period, or takes them back when negative
+func (s *solverSearch) add(k int, i int, blocks int) {
+	before := s.used[i]
+	s.used[i] += blocks
+	s.alloc[k][i] += blocks
+
+	chunks := s.chunk
+	if blocks < 0 {
+		chunks = -chunks
+	}
+	s.cost += s.weight[k]*i*blocks + chunks + s.used[i]*s.used[i] - before*before
+}
@@ -0,0 +1,79 @@
+package planner
+
+import (
+	"fmt"
+	"planner-microservice/units"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSolverFindsPackingGreedyMisses(t *testing.T) {
+	// the two periods hold the tasks exactly: 3 + 3 and 4 + 2
+	tasks := []Task{
+		{Todo: Todo{Id: "essay", Title: "essay", RequiredTime: 3}, Priority: 3},
+		{Todo: Todo{Id: "mail", Title: "mail", RequiredTime: 2}, Priority: 3, IsBreakable: true},
+		{Todo: Todo{Id: "slides", Title: "slides", RequiredTime: 3}, Priority: 2},
+		{Todo: Todo{Id: "lab", Title: "lab", RequiredTime: 4}, Priority: 1},
+	}
+
+	p := NewPlanner(units.Hour, units.Day, tasks, nil, 2, 6)
+	if _, err := p.GenerateTable(); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.AppendedPeriods()) == 0 {
+		t.Fatal("greedy fits the tasks in the requested periods, the case tests nothing")
+	}
+
+	p = NewPlanner(units.Hour, units.Day, tasks, nil, 2, 6)
+	p.SetStrategy(solver{})
+	table, err := p.GenerateTable()
+	if err != nil {
+		t.Fatalf("GenerateTable() error = %v", err)
+	}
+	if got := p.AppendedPeriods(); len(got) > 0 || len(table) != 2 {
+		t.Errorf("solver appended periods %v", got)
+	}
+	for _, task := range tasks {
+		if got := len(p.slotsOf(task.Id, false)); got != task.RequiredTime {
+			t.Errorf("%s has %d blocks, want %d", task.Id, got, task.RequiredTime)
+		}
+		if _, err := p.misplaced(task); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestSolverFallsBackToGreedy(t *testing.T) {
+	// a period of 5 blocks holds a single task of 3, the search for room for
+	// 16 of them in 10 periods runs until the budget does
+	var tasks []Task
+	for i := range 16 {
+		id := fmt.Sprint("task", i)
+		tasks = append(tasks, Task{Todo: Todo{Id: id, Title: id, RequiredTime: 3}, Priority: 1})
+	}
+
+	p := NewPlanner(units.Hour, units.Day, tasks, nil, 10, 5)
+	p.SetStrategy(solver{})
+	p.SetTimeBudget(50 * time.Millisecond)
+	start := time.Now()
+	table, err := p.GenerateTable()
+	if err != nil {
+		t.Fatalf("GenerateTable() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("GenerateTable() took %s with a budget of 50ms", elapsed)
+	}
+
+	greedy := NewPlanner(units.Hour, units.Day, tasks, nil, 10, 5)
+	want, err := greedy.GenerateTable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.EqualFunc(table, want, slices.Equal) {
+		t.Errorf("GenerateTable() = %v, want the greedy table %v", table, want)
+	}
+	if got := p.AppendedPeriods(); !slices.Equal(got, greedy.AppendedPeriods()) {
+		t.Errorf("AppendedPeriods() = %v, want %v", got, greedy.AppendedPeriods())
+	}
+}
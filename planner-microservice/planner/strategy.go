@@ -0,0 +1,101 @@
+package planner
+
+import "slices"
+
+// Strategy decides which periods the tasks of a plan go in. It runs after
+// the pinned cells and routines are placed and before every period is laid
+// out, strategies work on the planner's table so they live in this package.
+type Strategy interface {
+	Name() string
+	Description() string
+	// placeTasks adds the cells of the tasks, without their pinned blocks, to the table
+	placeTasks(p *Planner, tasks []Task) error
+}
+
+// DefaultStrategy is used when a plan doesn't name one
+const DefaultStrategy = "greedy"
+
+var strategies = []Strategy{greedy{}, balanced{}, frontLoaded{}, solver{}}
+
+// Strategies returns the strategies plans can be generated with
+func Strategies() []Strategy {
+	return append([]Strategy{}, strategies...)
+}
+
+// StrategyNamed returns the strategy with the given name, the default one for an empty name
+func StrategyNamed(name string) (Strategy, bool) {
+	if name == "" {
+		name = DefaultStrategy
+	}
+	for _, strategy := range strategies {
+		if strategy.Name() == name {
+			return strategy, true
+		}
+	}
+	return nil, false
+}
+
+// SetStrategy chooses how the tasks are placed, the default strategy is used when nil
+func (p *Planner) SetStrategy(strategy Strategy) {
+	p.strategy = strategy
+}
+
+// Strategy returns the strategy the tasks are placed with
+func (p *Planner) Strategy() Strategy {
+	if p.strategy == nil {
+		strategy, _ := StrategyNamed(DefaultStrategy)
+		return strategy
+	}
+	return p.strategy
+}
+
+// pushBlocks appends blocks cells of a task to a period
+func (p *Planner) pushBlocks(task Task, index int, blocks int) {
+	for range blocks {
+		p.table[index] = append(p.table[index], TableCell{
+			Type:   "task",
+			TodoId: task.Id,
+		})
+	}
+}
+
+// taskWindow returns the periods a task can go in, leaving room after
+// it for the tasks waiting for it
+func (p *Planner) taskWindow(task Task) (int, int) {
+	start, end := p.window(task)
+	if lead := p.leadTime(task); lead > 0 {
+		end = max(end-lead, start)
+	}
+	return start, end
+}
+
+// pushLeftover places the blocks of a task a strategy had no room for in
+// its window. A leftover smaller than a chunk joins a chunk already placed,
+// when none has room the task's blocks are taken back and the greedy
+// strategy pushes the whole task, so no period holds less than a chunk
+func (p *Planner) pushLeftover(task Task) error {
+	least, most := chunkBounds(task, p.wholeSize(task))
+	if task.RequiredTime >= least {
+		return p.pushTask(task)
+	}
+
+	start, end := p.window(task)
+	for i := start; i <= end && i < len(p.table); i++ {
+		placed := p.taskBlocksIn(task.Id, i)
+		if placed > 0 && placed+task.RequiredTime <= most && p.isPlacesAvailable(task.RequiredTime, i) &&
+			p.fitsWhole(task.Id, i, task.RequiredTime) {
+			p.pushBlocks(task, i, task.RequiredTime)
+			return nil
+		}
+	}
+
+	for i, period := range p.table {
+		p.table[i] = slices.DeleteFunc(period, func(cell TableCell) bool {
+			return cell.Type == "task" && cell.TodoId == task.Id && !cell.Pinned
+		})
+	}
+	if whole, ok := p.findTask(task.Id); ok {
+		task.RequiredTime = p.remainingTime(whole)
+	}
+	return p.pushTask(task)
+}
@@ -0,0 +1,98 @@
+package planner
+
+import (
+	"errors"
+	"planner-microservice/units"
+	"testing"
+)
+
+// TestStrategiesKeepChunks checks that no strategy leaves a chunk smaller
+// than min_chunk or larger than max_chunk when the window fills up
+func TestStrategiesKeepChunks(t *testing.T) {
+	for _, strategy := range Strategies() {
+		t.Run(strategy.Name(), func(t *testing.T) {
+			tasks := []Task{
+				{Todo: Todo{Id: "c", Title: "c", RequiredTime: 5}, Priority: 1, IsBreakable: true, MinChunk: 2, MaxChunk: 3},
+				{Todo: Todo{Id: "x", Title: "x", RequiredTime: 4}, Priority: 1, IsBreakable: true},
+				{Todo: Todo{Id: "u", Title: "u", RequiredTime: 3}, Priority: 1},
+			}
+			p := NewPlanner(units.Hour, units.Day, tasks, nil, 3, 4)
+			p.SetStrategy(strategy)
+
+			if _, err := p.GenerateTable(); err != nil {
+				t.Fatal(err)
+			}
+			for i := range p.table {
+				if blocks := p.taskBlocksIn("c", i); blocks != 0 && (blocks < 2 || blocks > 3) {
+					t.Errorf("period %d holds %d blocks of c, want 2 to 3", i, blocks)
+				}
+			}
+			if unscheduled := p.Unscheduled(); len(unscheduled) > 0 {
+				t.Errorf("unscheduled %+v", unscheduled)
+			}
+		})
+	}
+}
+
+// TestMakingRoomKeepsChunks checks that the cells moved to make room for a
+// task leave every period with chunks inside the bounds of their task
+func TestMakingRoomKeepsChunks(t *testing.T) {
+	tests := []struct {
+		name    string
+		tasks   []Task
+		periods int
+	}{
+		{
+			name: "max chunk",
+			tasks: []Task{
+				{Todo: Todo{Id: "t0", Title: "t0", RequiredTime: 6}, Priority: 1},
+				{Todo: Todo{Id: "t1", Title: "t1", RequiredTime: 4}, Priority: 3, IsBreakable: true, MaxChunk: 3},
+			},
+			periods: 2,
+		},
+		{
+			name: "min chunk",
+			tasks: []Task{
+				{Todo: Todo{Id: "t0", Title: "t0", RequiredTime: 5}, Priority: 3, IsBreakable: true, MinChunk: 2, MaxChunk: 4},
+				{Todo: Todo{Id: "t1", Title: "t1", RequiredTime: 6}, Priority: 1},
+				{Todo: Todo{Id: "t2", Title: "t2", RequiredTime: 5}, Priority: 1, Prerequisites: []string{"t0"}},
+			},
+			periods: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		for _, strategy := range Strategies() {
+			t.Run(tt.name+"/"+strategy.Name(), func(t *testing.T) {
+				p := NewPlanner(units.Hour, units.Day, tt.tasks, nil, tt.periods, 6)
+				p.SetStrategy(strategy)
+				if _, err := p.GenerateTable(); err != nil {
+					t.Fatal(err)
+				}
+				for _, task := range tt.tasks {
+					least, most := chunkBounds(task, task.RequiredTime)
+					placed := 0
+					for i := range p.table {
+						blocks := p.taskBlocksIn(task.Id, i)
+						if blocks != 0 && (blocks < least || blocks > most) {
+							t.Errorf("period %d holds %d blocks of %s, want %d to %d", i, blocks, task.Id, least, most)
+						}
+						placed += blocks
+					}
+					if placed != task.RequiredTime {
+						t.Errorf("%s has %d blocks, want %d", task.Id, placed, task.RequiredTime)
+					}
+				}
+
+				// the requested periods can't hold the tasks in whole chunks
+				p = NewPlanner(units.Hour, units.Day, tt.tasks, nil, tt.periods, 6)
+				p.SetStrategy(strategy)
+				p.SetOverflow(OverflowStrict)
+				var capacityErr *CapacityError
+				if _, err := p.GenerateTable(); !errors.As(err, &capacityErr) {
+					t.Errorf("GenerateTable() of a strict plan error = %v, want a CapacityError", err)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package planner
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Unscheduled is a todo the generated table holds fewer blocks of than it needs
+type Unscheduled struct {
+	Type    string // "task" or "routine"
+	TodoId  string
+	Missing int    // blocks of the todo left out of the table
+	Periods []int  // periods a routine is left out of, nil for tasks
+	Reason  string // why they were left out, naming the todo
+}
+
+// Unscheduled returns the todos the last generated table doesn't hold all the blocks of
+func (p *Planner) Unscheduled() []Unscheduled {
+	return p.unscheduled
+}
+
+// AppendedPeriods returns the indexes of the periods the last generated table
+// got after the requested ones to make room for tasks
+func (p *Planner) AppendedPeriods() []int {
+	return p.appended_periods
+}
+
+// leaveUnscheduled records why a strategy couldn't place the rest of a task,
+// the blocks already placed stay in the table. Plans that grow never leave a
+// task out, err is returned for them instead.
+func (p *Planner) leaveUnscheduled(task Task, err error) error {
+	if p.canGrow() {
+		return err
+	}
+	if p.unscheduled_reasons == nil {
+		p.unscheduled_reasons = make(map[string]string)
+	}
+	if _, ok := p.unscheduled_reasons[task.Id]; !ok {
+		p.unscheduled_reasons[task.Id] = err.Error()
+	}
+	return nil
+}
+
+// findUnscheduled counts the blocks of every task in the table, so no task
+// goes missing from a plan without being reported
+func (p *Planner) findUnscheduled() []Unscheduled {
+	var unscheduled []Unscheduled
+	for _, task := range p.tasks {
+		placed := 0
+		for i := range p.table {
+			placed += p.taskBlocksIn(task.Id, i)
+		}
+		if placed >= task.RequiredTime {
+			continue
+		}
+
+		reason, ok := p.unscheduled_reasons[task.Id]
+		if !ok {
+			reason = fmt.Sprintf("task %q was left out by the %s strategy", task.Title, p.Strategy().Name())
+		}
+		unscheduled = append(unscheduled, Unscheduled{
+			Type:    "task",
+			TodoId:  task.Id,
+			Missing: task.RequiredTime - placed,
+			Reason:  reason,
+		})
+	}
+	return unscheduled
+}
+
+// checkPlacement looks for the tasks the final table splits while they're
+// unbreakable, splits within a period, places outside their window or in
+// chunks outside their bounds, strategies and moves that make room can
+// leave them behind. Plans that
+// grow fail with the first of them, strict and best-effort plans leave
+// their blocks out and report them.
+func (p *Planner) checkPlacement() error {
+	for _, task := range p.tasks {
+		periods, err := p.misplaced(task)
+		if err == nil {
+			continue
+		}
+		if err := p.leaveUnscheduled(task, err); err != nil {
+			return err
+		}
+		for _, i := range periods {
+			p.dropCells(i, func(cell TableCell) bool {
+				return cell.Type == "task" && cell.TodoId == task.Id && !cell.Pinned
+			})
+		}
+	}
+	return nil
+}
+
+// misplaced explains the first rule the blocks of a task break in the laid
+// out table, with the periods to take them out of, nil when they break none
+func (p *Planner) misplaced(task Task) ([]int, error) {
+	start, end := p.window(task)
+
+	var periods, outside []int
+	outsideBlocks := 0
+	for i := range p.table {
+		blocks := p.taskBlocksIn(task.Id, i)
+		if blocks == 0 {
+			continue
+		}
+		periods = append(periods, i)
+		if (i < start || i > end) && blocks > p.pinnedTime("task", task.Id, i) {
+			outside = append(outside, i)
+			outsideBlocks += blocks
+		}
+	}
+
+	if !task.IsBreakable && len(periods) > 1 {
+		return periods, fmt.Errorf("task %q can't be broken up but would be split over %d periods", task.Title, len(periods))
+	}
+	if len(outside) > 0 {
+		return outside, fmt.Errorf("task %q would have %d blocks outside periods %d to %d", task.Title, outsideBlocks, start+1, end+1)
+	}
+	for _, i := range periods {
+		if slices.Contains(splitTasks(p.table[i]), task.Id) {
+			return []int{i}, fmt.Errorf("task %q would be split within period %d", task.Title, i+1)
+		}
+	}
+
+	// periods holding only pinned blocks of the task keep them as they are
+	least, most := chunkBounds(task, p.wholeSize(task))
+	for _, i := range periods {
+		blocks := p.taskBlocksIn(task.Id, i)
+		if blocks > p.pinnedTime("task", task.Id, i) && (blocks < least || blocks > most) {
+			return []int{i}, fmt.Errorf("task %q would have %d blocks in period %d, not chunks of %d to %d blocks", task.Title, blocks, i+1, least, min(most, p.wholeSize(task)))
+		}
+	}
+
+	// the blocks that aren't pinned come after every block of its
+	// prerequisites and before every block of its dependents
+	for _, id := range task.Prerequisites {
+		prereq, _ := p.findTask(id)
+		if slots := p.slotsOf(id, false); len(slots) > 0 {
+			if early := p.unpinnedAround(task.Id, slots[len(slots)-1], true); len(early) > 0 {
+				return early, fmt.Errorf("task %q would start before its prerequisite %q is done", task.Title, prereq.Title)
+			}
+		}
+	}
+	for _, dependent := range p.dependentsOf(task.Id) {
+		if slots := p.slotsOf(dependent.Id, false); len(slots) > 0 {
+			if late := p.unpinnedAround(task.Id, slots[0], false); len(late) > 0 {
+				return late, fmt.Errorf("task %q would end after %q, which waits for it, starts", task.Title, dependent.Title)
+			}
+		}
+	}
+	return nil, nil
+}
+
+// slotsOf returns the slots of the cells of a task in the laid out table in
+// order, only the ones that aren't pinned when unpinned is set
+func (p *Planner) slotsOf(id string, unpinned bool) []Slot {
+	var slots []Slot
+	for i, period := range p.table {
+		for block, cell := range period {
+			if cell.Type == "task" && cell.TodoId == id && !(unpinned && cell.Pinned) {
+				slots = append(slots, Slot{Period: i, Block: block})
+			}
+		}
+	}
+	return slots
+}
+
+// unpinnedAround returns the periods holding cells of a task that aren't
+// pinned before slot when before is set, after it otherwise
+func (p *Planner) unpinnedAround(id string, slot Slot, before bool) []int {
+	var periods []int
+	for _, own := range p.slotsOf(id, true) {
+		if slotBefore(own, slot) == before && own != slot && !slices.Contains(periods, own.Period) {
+			periods = append(periods, own.Period)
+		}
+	}
+	return periods
+}
+
+// dropCells frees the cells of a laid out period that match, the free
+// and blocked slots after the last cell left are left out
+func (p *Planner) dropCells(index int, match func(TableCell) bool) {
+	period := p.table[index]
+	for block, cell := range period {
+		if match(cell) {
+			period[block] = TableCell{
+				Type: "free",
+			}
+		}
+	}
+
+	for len(period) > 0 && (period[len(period)-1].Type == "free" || period[len(period)-1].Type == "blocked") {
+		period = period[:len(period)-1]
+	}
+	p.table[index] = period
+}
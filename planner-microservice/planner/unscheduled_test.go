@@ -0,0 +1,206 @@
+package planner
+
+import (
+	"planner-microservice/units"
+	"slices"
+	"testing"
+)
+
+// TestGenerateTableKeepsUnbreakableTasks checks that no strategy splits an
+// unbreakable task, over periods or within one, or places it after its deadline
+func TestGenerateTableKeepsUnbreakableTasks(t *testing.T) {
+	deadline := 1
+	crowded := []Task{
+		{Todo: Todo{Id: "a", Title: "a", RequiredTime: 3}, Priority: 1, Deadline: &deadline},
+		{Todo: Todo{Id: "b", Title: "b", RequiredTime: 3}, Priority: 1, Deadline: &deadline},
+		{Todo: Todo{Id: "c", Title: "c", RequiredTime: 2}, Priority: 1, Deadline: &deadline},
+	}
+	blocked := []Task{
+		{Todo: Todo{Id: "a", Title: "a", RequiredTime: 3}, Priority: 1, Deadline: &deadline},
+		{Todo: Todo{Id: "b", Title: "b", RequiredTime: 2}, Priority: 1, Deadline: &deadline},
+		{Todo: Todo{Id: "c", Title: "c", RequiredTime: 4}, Priority: 1, Deadline: &deadline},
+	}
+
+	tests := []struct {
+		name            string
+		tasks           []Task
+		blocked         []Slot
+		blocks          int
+		overflow        Overflow
+		wantErr         bool
+		wantUnscheduled []string
+	}{
+		{name: "crowded/grow", tasks: crowded, blocks: 4, overflow: OverflowGrow, wantErr: true},
+		{name: "crowded/strict", tasks: crowded, blocks: 4, overflow: OverflowStrict, wantErr: true},
+		{name: "crowded/best effort", tasks: crowded, blocks: 4, overflow: OverflowBestEffort, wantUnscheduled: []string{"c"}},
+		{name: "blocked slots/grow", tasks: blocked, blocked: []Slot{{Period: 0, Block: 4}}, blocks: 6, overflow: OverflowGrow},
+		{name: "blocked slots/strict", tasks: blocked, blocked: []Slot{{Period: 0, Block: 4}}, blocks: 6, overflow: OverflowStrict},
+	}
+
+	for _, tt := range tests {
+		for _, strategy := range Strategies() {
+			t.Run(tt.name+"/"+strategy.Name(), func(t *testing.T) {
+				p := NewPlanner(units.Hour, units.Day, tt.tasks, nil, 3, tt.blocks)
+				p.SetBlockedSlots(tt.blocked)
+				p.SetStrategy(strategy)
+				p.SetOverflow(tt.overflow)
+
+				table, err := p.GenerateTable()
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("GenerateTable() error = %v, want error %v", err, tt.wantErr)
+				}
+				if err != nil {
+					return
+				}
+
+				for _, task := range tt.tasks {
+					var periods []int
+					for i := range table {
+						if p.taskBlocksIn(task.Id, i) > 0 {
+							periods = append(periods, i)
+						}
+					}
+					if slices.Contains(tt.wantUnscheduled, task.Id) {
+						continue
+					}
+					if len(periods) != 1 {
+						t.Errorf("%s is in periods %v, want a single one", task.Id, periods)
+						continue
+					}
+					if periods[0] > deadline {
+						t.Errorf("period %d holds %s after its deadline", periods[0], task.Id)
+					}
+					period := table[periods[0]]
+					first := slices.IndexFunc(period, func(cell TableCell) bool { return cell.TodoId == task.Id })
+					if first+task.RequiredTime > len(period) || slices.ContainsFunc(period[first:first+task.RequiredTime], func(cell TableCell) bool {
+						return cell.TodoId != task.Id
+					}) {
+						t.Errorf("period %d splits %s: %v", periods[0], task.Id, period)
+					}
+				}
+
+				var unscheduled []string
+				for _, todo := range p.Unscheduled() {
+					unscheduled = append(unscheduled, todo.TodoId)
+				}
+				if !slices.Equal(unscheduled, tt.wantUnscheduled) {
+					t.Errorf("unscheduled %v, want %v", unscheduled, tt.wantUnscheduled)
+				}
+			})
+		}
+	}
+}
+
+func TestCheckPlacement(t *testing.T) {
+	deadline := 0
+	tasks := []Task{
+		{Todo: Todo{Id: "a", Title: "a", RequiredTime: 3}, Priority: 1},
+		{Todo: Todo{Id: "b", Title: "b", RequiredTime: 2}, Priority: 1, IsBreakable: true, Deadline: &deadline},
+	}
+	a := TableCell{Type: "task", TodoId: "a"}
+	b := TableCell{Type: "task", TodoId: "b"}
+	free := TableCell{Type: "free"}
+
+	tests := []struct {
+		name     string
+		overflow Overflow
+		wantErr  bool
+		want     [][]TableCell
+		missing  map[string]int
+	}{
+		{
+			name:     "grow",
+			overflow: OverflowGrow,
+			wantErr:  true,
+		},
+		{
+			name:     "best effort",
+			overflow: OverflowBestEffort,
+			want:     [][]TableCell{{free, free, b}, {}},
+			missing:  map[string]int{"a": 3, "b": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPlanner(units.Hour, units.Day, tasks, nil, 2, 4)
+			p.SetOverflow(tt.overflow)
+			p.table = [][]TableCell{{a, a, b}, {a, b}}
+
+			err := p.checkPlacement()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkPlacement() error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			for i, period := range tt.want {
+				if !slices.Equal(p.table[i], period) {
+					t.Errorf("period %d = %v, want %v", i, p.table[i], period)
+				}
+			}
+			unscheduled := p.findUnscheduled()
+			if len(unscheduled) != len(tt.missing) {
+				t.Fatalf("unscheduled %+v, want %v", unscheduled, tt.missing)
+			}
+			for _, todo := range unscheduled {
+				if todo.Missing != tt.missing[todo.TodoId] {
+					t.Errorf("%s misses %d blocks, want %d", todo.TodoId, todo.Missing, tt.missing[todo.TodoId])
+				}
+			}
+		})
+	}
+}
+
+func TestCheckPlacementSplitWithinPeriod(t *testing.T) {
+	tasks := []Task{
+		{Todo: Todo{Id: "a", Title: "a", RequiredTime: 2}, Priority: 1, IsBreakable: true},
+		{Todo: Todo{Id: "b", Title: "b", RequiredTime: 1}, Priority: 1, IsBreakable: true},
+	}
+	a := TableCell{Type: "task", TodoId: "a"}
+	b := TableCell{Type: "task", TodoId: "b"}
+
+	p := NewPlanner(units.Hour, units.Day, tasks, nil, 1, 4)
+	p.table = [][]TableCell{{a, b, a}}
+	if err := p.checkPlacement(); err == nil {
+		t.Error("checkPlacement() of a growing plan splitting a within its period succeeded, want an error")
+	}
+
+	p.SetOverflow(OverflowBestEffort)
+	p.table = [][]TableCell{{a, b, a}}
+	if err := p.checkPlacement(); err != nil {
+		t.Fatal(err)
+	}
+	if want := []TableCell{{Type: "free"}, b}; !slices.Equal(p.table[0], want) {
+		t.Errorf("period 0 = %v, want %v", p.table[0], want)
+	}
+	if unscheduled := p.findUnscheduled(); len(unscheduled) != 1 || unscheduled[0].TodoId != "a" || unscheduled[0].Missing != 2 {
+		t.Errorf("unscheduled %+v, want the 2 blocks of a", unscheduled)
+	}
+}
+
+func TestCheckPlacementChunkBounds(t *testing.T) {
+	tasks := []Task{
+		{Todo: Todo{Id: "a", Title: "a", RequiredTime: 5}, Priority: 1, IsBreakable: true, MinChunk: 2, MaxChunk: 3},
+	}
+	a := TableCell{Type: "task", TodoId: "a"}
+
+	p := NewPlanner(units.Hour, units.Day, tasks, nil, 2, 6)
+	p.table = [][]TableCell{{a, a, a, a}, {a}}
+	if err := p.checkPlacement(); err == nil {
+		t.Error("checkPlacement() of a growing plan with chunks of 4 and 1 succeeded, want an error")
+	}
+
+	p.SetOverflow(OverflowBestEffort)
+	p.table = [][]TableCell{{a, a, a}, {a}}
+	if err := p.checkPlacement(); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.table[1]) != 0 {
+		t.Errorf("period 1 = %v, want the chunk of 1 left out", p.table[1])
+	}
+	if unscheduled := p.findUnscheduled(); len(unscheduled) != 1 || unscheduled[0].Missing != 2 {
+		t.Errorf("unscheduled %+v, want 2 blocks of a", unscheduled)
+	}
+}
@@ -20,14 +20,241 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TimeUnit int32
+
+const (
+	TimeUnit_TIME_UNIT_UNSPECIFIED TimeUnit = 0
+	TimeUnit_TIME_UNIT_MINUTE      TimeUnit = 1
+	TimeUnit_TIME_UNIT_HOUR        TimeUnit = 2
+	TimeUnit_TIME_UNIT_DAY         TimeUnit = 3
+	TimeUnit_TIME_UNIT_WEEK        TimeUnit = 4
+	// 30 days when compared to other units, periods of months keep the day of the month
+	TimeUnit_TIME_UNIT_MONTH TimeUnit = 5
+)
+
+// Enum value maps for TimeUnit.
+var (
+	TimeUnit_name = map[int32]string{
+		0: "TIME_UNIT_UNSPECIFIED",
+		1: "TIME_UNIT_MINUTE",
+		2: "TIME_UNIT_HOUR",
+		3: "TIME_UNIT_DAY",
+		4: "TIME_UNIT_WEEK",
+		5: "TIME_UNIT_MONTH",
+	}
+	TimeUnit_value = map[string]int32{
+		"TIME_UNIT_UNSPECIFIED": 0,
+		"TIME_UNIT_MINUTE":      1,
+		"TIME_UNIT_HOUR":        2,
+		"TIME_UNIT_DAY":         3,
+		"TIME_UNIT_WEEK":        4,
+		"TIME_UNIT_MONTH":       5,
+	}
+)
+
+func (x TimeUnit) Enum() *TimeUnit {
+	p := new(TimeUnit)
+	*p = x
+	return p
+}
+
+func (x TimeUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_planner_proto_enumTypes[0].Descriptor()
+}
+
+func (TimeUnit) Type() protoreflect.EnumType {
+	return &file_proto_planner_proto_enumTypes[0]
+}
+
+func (x TimeUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeUnit.Descriptor instead.
+func (TimeUnit) EnumDescriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{0}
+}
+
+type OverflowPolicy int32
+
+const (
+	// Periods are appended after n_periods until every task fits, the plan
+	// fails when a task can't be placed in its window
+	OverflowPolicy_OVERFLOW_POLICY_GROW OverflowPolicy = 0
+	// The plan fails, explaining every todo that doesn't fit in n_periods
+	OverflowPolicy_OVERFLOW_POLICY_STRICT OverflowPolicy = 1
+	// The plan keeps n_periods, what fits is placed by priority and the rest is listed in unscheduled
+	OverflowPolicy_OVERFLOW_POLICY_BEST_EFFORT OverflowPolicy = 2
+)
+
+// Enum value maps for OverflowPolicy.
+var (
+	OverflowPolicy_name = map[int32]string{
+		0: "OVERFLOW_POLICY_GROW",
+		1: "OVERFLOW_POLICY_STRICT",
+		2: "OVERFLOW_POLICY_BEST_EFFORT",
+	}
+	OverflowPolicy_value = map[string]int32{
+		"OVERFLOW_POLICY_GROW":        0,
+		"OVERFLOW_POLICY_STRICT":      1,
+		"OVERFLOW_POLICY_BEST_EFFORT": 2,
+	}
+)
+
+func (x OverflowPolicy) Enum() *OverflowPolicy {
+	p := new(OverflowPolicy)
+	*p = x
+	return p
+}
+
+func (x OverflowPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OverflowPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_planner_proto_enumTypes[1].Descriptor()
+}
+
+func (OverflowPolicy) Type() protoreflect.EnumType {
+	return &file_proto_planner_proto_enumTypes[1]
+}
+
+func (x OverflowPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OverflowPolicy.Descriptor instead.
+func (OverflowPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{1}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	// A row per block
+	ExportFormat_EXPORT_FORMAT_CSV ExportFormat = 1
+	// A checklist per period
+	ExportFormat_EXPORT_FORMAT_MARKDOWN ExportFormat = 2
+	// A standalone printable grid
+	ExportFormat_EXPORT_FORMAT_HTML ExportFormat = 3
+	// An iCalendar document, the plan needs a start_datetime
+	ExportFormat_EXPORT_FORMAT_ICS ExportFormat = 4
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_MARKDOWN",
+		3: "EXPORT_FORMAT_HTML",
+		4: "EXPORT_FORMAT_ICS",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_MARKDOWN":    2,
+		"EXPORT_FORMAT_HTML":        3,
+		"EXPORT_FORMAT_ICS":         4,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_planner_proto_enumTypes[2].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_proto_planner_proto_enumTypes[2]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{2}
+}
+
+type ImportFormat int32
+
+const (
+	ImportFormat_IMPORT_FORMAT_UNSPECIFIED ImportFormat = 0
+	// A row per todo under a header row (title, duration, priority, breakable, type, ...)
+	ImportFormat_IMPORT_FORMAT_CSV ImportFormat = 1
+	// Task list items like "- [ ] Read chapter 3 (3h, high)"
+	ImportFormat_IMPORT_FORMAT_MARKDOWN ImportFormat = 2
+	// A JSON export of Todoist tasks
+	ImportFormat_IMPORT_FORMAT_TODOIST ImportFormat = 3
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_UNSPECIFIED",
+		1: "IMPORT_FORMAT_CSV",
+		2: "IMPORT_FORMAT_MARKDOWN",
+		3: "IMPORT_FORMAT_TODOIST",
+	}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_UNSPECIFIED": 0,
+		"IMPORT_FORMAT_CSV":         1,
+		"IMPORT_FORMAT_MARKDOWN":    2,
+		"IMPORT_FORMAT_TODOIST":     3,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_planner_proto_enumTypes[3].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_proto_planner_proto_enumTypes[3]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{3}
+}
+
 type Todo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title        string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description  string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Blocks the todo takes, at most 100000
 	RequiredTime int32  `protobuf:"varint,4,opt,name=required_time,json=requiredTime,proto3" json:"required_time,omitempty"`
 	Type         string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
 }
@@ -104,9 +331,21 @@ type Task struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Todo        *Todo `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	Todo *Todo `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	// Weight of the task, at least 1, higher priorities are placed first and
+	// by the greedy strategy in the first periods of their window, a task
+	// weighing 3 next to tasks weighing 1 in the first third of it
 	Priority    int32 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
 	IsBreakable bool  `protobuf:"varint,3,opt,name=is_breakable,json=isBreakable,proto3" json:"is_breakable,omitempty"`
+	// Latest period index (0-based) the task may be placed in
+	Deadline *int32 `protobuf:"varint,4,opt,name=deadline,proto3,oneof" json:"deadline,omitempty"`
+	// Ids of the tasks that have to be done before this one starts
+	Prerequisites []string `protobuf:"bytes,5,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"`
+	// First period index (0-based) the task may be placed in
+	EarliestStart *int32 `protobuf:"varint,6,opt,name=earliest_start,json=earliestStart,proto3,oneof" json:"earliest_start,omitempty"`
+	// Least and most blocks of a breakable task in a single period, 0 when unbounded
+	MinChunk int32 `protobuf:"varint,7,opt,name=min_chunk,json=minChunk,proto3" json:"min_chunk,omitempty"`
+	MaxChunk int32 `protobuf:"varint,8,opt,name=max_chunk,json=maxChunk,proto3" json:"max_chunk,omitempty"`
 }
 
 func (x *Task) Reset() {
@@ -162,12 +401,59 @@ func (x *Task) GetIsBreakable() bool {
 	return false
 }
 
+func (x *Task) GetDeadline() int32 {
+	if x != nil && x.Deadline != nil {
+		return *x.Deadline
+	}
+	return 0
+}
+
+func (x *Task) GetPrerequisites() []string {
+	if x != nil {
+		return x.Prerequisites
+	}
+	return nil
+}
+
+func (x *Task) GetEarliestStart() int32 {
+	if x != nil && x.EarliestStart != nil {
+		return *x.EarliestStart
+	}
+	return 0
+}
+
+func (x *Task) GetMinChunk() int32 {
+	if x != nil {
+		return x.MinChunk
+	}
+	return 0
+}
+
+func (x *Task) GetMaxChunk() int32 {
+	if x != nil {
+		return x.MaxChunk
+	}
+	return 0
+}
+
 type Routine struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Todo *Todo `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	// Where the routine sits in every period: "start" (default), "end" or "block"
+	Position string `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	// Block index of the routine's first cell when position is "block"
+	Block int32 `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
+	// Recurs every this many periods, 0 or 1 for every period, at most 10000
+	Every int32 `protobuf:"varint,4,opt,name=every,proto3" json:"every,omitempty"`
+	// Periods of every cycle of `every` periods the routine is in, the first one when empty
+	Offsets []int32 `protobuf:"varint,5,rep,packed,name=offsets,proto3" json:"offsets,omitempty"`
+	// Days of the week the routine is in ("monday" to "sunday"), for plans of days
+	Weekdays []string `protobuf:"bytes,6,rep,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// Number of periods the routine is in, chosen by the planner, 0 for all it recurs in, at most 10000
+	Times int32 `protobuf:"varint,7,opt,name=times,proto3" json:"times,omitempty"`
 }
 
 func (x *Routine) Reset() {
@@ -209,13 +495,61 @@ func (x *Routine) GetTodo() *Todo {
 	return nil
 }
 
+func (x *Routine) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *Routine) GetBlock() int32 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+func (x *Routine) GetEvery() int32 {
+	if x != nil {
+		return x.Every
+	}
+	return 0
+}
+
+func (x *Routine) GetOffsets() []int32 {
+	if x != nil {
+		return x.Offsets
+	}
+	return nil
+}
+
+func (x *Routine) GetWeekdays() []string {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *Routine) GetTimes() int32 {
+	if x != nil {
+		return x.Times
+	}
+	return 0
+}
+
 type TableCell struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// "task", "routine", "blocked" or "free"
 	Type   string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	TodoId string `protobuf:"bytes,2,opt,name=todo_id,json=todoId,proto3" json:"todo_id,omitempty"`
+	// Kept in its slot from the previous plan by ReplanPlan
+	Pinned bool `protobuf:"varint,3,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// RFC 3339 datetimes of the block, set when the plan has a start_datetime
+	Start string `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
 }
 
 func (x *TableCell) Reset() {
@@ -264,21 +598,38 @@ func (x *TableCell) GetTodoId() string {
 	return ""
 }
 
-type PlanRequest struct {
+func (x *TableCell) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *TableCell) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *TableCell) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type Slot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BuildUnit  string     `protobuf:"bytes,1,opt,name=build_unit,json=buildUnit,proto3" json:"build_unit,omitempty"`
-	PeriodUnit string     `protobuf:"bytes,2,opt,name=period_unit,json=periodUnit,proto3" json:"period_unit,omitempty"`
-	Tasks      []*Task    `protobuf:"bytes,3,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	Routines   []*Routine `protobuf:"bytes,4,rep,name=routines,proto3" json:"routines,omitempty"`
-	NPeriods   int32      `protobuf:"varint,5,opt,name=n_periods,json=nPeriods,proto3" json:"n_periods,omitempty"`
-	NBlocks    int32      `protobuf:"varint,6,opt,name=n_blocks,json=nBlocks,proto3" json:"n_blocks,omitempty"`
+	Period int32 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	Block  int32 `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
 }
 
-func (x *PlanRequest) Reset() {
-	*x = PlanRequest{}
+func (x *Slot) Reset() {
+	*x = Slot{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_planner_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -286,13 +637,13 @@ func (x *PlanRequest) Reset() {
 	}
 }
 
-func (x *PlanRequest) String() string {
+func (x *Slot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlanRequest) ProtoMessage() {}
+func (*Slot) ProtoMessage() {}
 
-func (x *PlanRequest) ProtoReflect() protoreflect.Message {
+func (x *Slot) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_planner_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -304,64 +655,38 @@ func (x *PlanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlanRequest.ProtoReflect.Descriptor instead.
-func (*PlanRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Slot.ProtoReflect.Descriptor instead.
+func (*Slot) Descriptor() ([]byte, []int) {
 	return file_proto_planner_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *PlanRequest) GetBuildUnit() string {
-	if x != nil {
-		return x.BuildUnit
-	}
-	return ""
-}
-
-func (x *PlanRequest) GetPeriodUnit() string {
-	if x != nil {
-		return x.PeriodUnit
-	}
-	return ""
-}
-
-func (x *PlanRequest) GetTasks() []*Task {
-	if x != nil {
-		return x.Tasks
-	}
-	return nil
-}
-
-func (x *PlanRequest) GetRoutines() []*Routine {
-	if x != nil {
-		return x.Routines
-	}
-	return nil
-}
-
-func (x *PlanRequest) GetNPeriods() int32 {
+func (x *Slot) GetPeriod() int32 {
 	if x != nil {
-		return x.NPeriods
+		return x.Period
 	}
 	return 0
 }
 
-func (x *PlanRequest) GetNBlocks() int32 {
+func (x *Slot) GetBlock() int32 {
 	if x != nil {
-		return x.NBlocks
+		return x.Block
 	}
 	return 0
 }
 
-type PlanResponse struct {
+// A length of time, like an hour or a 25 minute pomodoro
+type TimeLength struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Periods   []*Period `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"`
-	TotalTime string    `protobuf:"bytes,2,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
+	Unit TimeUnit `protobuf:"varint,1,opt,name=unit,proto3,enum=planner.TimeUnit" json:"unit,omitempty"`
+	// Number of units, 1 when 0
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *PlanResponse) Reset() {
-	*x = PlanResponse{}
+func (x *TimeLength) Reset() {
+	*x = TimeLength{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_planner_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -369,13 +694,13 @@ func (x *PlanResponse) Reset() {
 	}
 }
 
-func (x *PlanResponse) String() string {
+func (x *TimeLength) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlanResponse) ProtoMessage() {}
+func (*TimeLength) ProtoMessage() {}
 
-func (x *PlanResponse) ProtoReflect() protoreflect.Message {
+func (x *TimeLength) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_planner_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -387,35 +712,79 @@ func (x *PlanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlanResponse.ProtoReflect.Descriptor instead.
-func (*PlanResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TimeLength.ProtoReflect.Descriptor instead.
+func (*TimeLength) Descriptor() ([]byte, []int) {
 	return file_proto_planner_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PlanResponse) GetPeriods() []*Period {
+func (x *TimeLength) GetUnit() TimeUnit {
 	if x != nil {
-		return x.Periods
+		return x.Unit
 	}
-	return nil
+	return TimeUnit_TIME_UNIT_UNSPECIFIED
 }
 
-func (x *PlanResponse) GetTotalTime() string {
+func (x *TimeLength) GetCount() int32 {
 	if x != nil {
-		return x.TotalTime
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type Period struct {
+type PlanRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cells []*TableCell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	// Unit of a block ("minute", "hour", "day", "week", "month" or a number of them
+	// like "25 minutes"), set block_length instead
+	BuildUnit string `protobuf:"bytes,1,opt,name=build_unit,json=buildUnit,proto3" json:"build_unit,omitempty"`
+	// Unit of a period, written like build_unit, set period_length instead
+	PeriodUnit string     `protobuf:"bytes,2,opt,name=period_unit,json=periodUnit,proto3" json:"period_unit,omitempty"`
+	Tasks      []*Task    `protobuf:"bytes,3,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Routines   []*Routine `protobuf:"bytes,4,rep,name=routines,proto3" json:"routines,omitempty"`
+	// Periods of the plan, at most 10000
+	NPeriods int32 `protobuf:"varint,5,opt,name=n_periods,json=nPeriods,proto3" json:"n_periods,omitempty"`
+	NBlocks  int32 `protobuf:"varint,6,opt,name=n_blocks,json=nBlocks,proto3" json:"n_blocks,omitempty"`
+	// Blocks available in every period, n_blocks is used for the periods not listed
+	PeriodCapacities []int32 `protobuf:"varint,7,rep,packed,name=period_capacities,json=periodCapacities,proto3" json:"period_capacities,omitempty"`
+	// Periods where nothing can be placed (holidays, exam days)
+	BlockedPeriods []int32 `protobuf:"varint,8,rep,packed,name=blocked_periods,json=blockedPeriods,proto3" json:"blocked_periods,omitempty"`
+	// Single blocks where nothing can be placed (fixed meetings),
+	// blocks past the end of their period are ignored
+	BlockedSlots []*Slot `protobuf:"bytes,9,rep,name=blocked_slots,json=blockedSlots,proto3" json:"blocked_slots,omitempty"`
+	// Day of the week of the first period ("monday" to "sunday"), "monday" when empty,
+	// taken from start_datetime when it's given
+	StartWeekday string `protobuf:"bytes,10,opt,name=start_weekday,json=startWeekday,proto3" json:"start_weekday,omitempty"`
+	// Local date and time the first period starts at ("2006-01-02T15:04:05"),
+	// cells get no timestamps when empty
+	StartDatetime string `protobuf:"bytes,11,opt,name=start_datetime,json=startDatetime,proto3" json:"start_datetime,omitempty"`
+	// IANA time zone of start_datetime (e.g. "Europe/Berlin"), "UTC" when empty
+	TimeZone string `protobuf:"bytes,12,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Local start time ("15:04") of every block of a period of days,
+	// blocks follow each other from start_datetime when empty
+	BlockStartTimes []string `protobuf:"bytes,13,rep,name=block_start_times,json=blockStartTimes,proto3" json:"block_start_times,omitempty"`
+	// Days of the week periods of days skip ("monday" to "sunday")
+	SkippedWeekdays []string `protobuf:"bytes,14,rep,name=skipped_weekdays,json=skippedWeekdays,proto3" json:"skipped_weekdays,omitempty"`
+	// How the tasks are placed, one of the names ListStrategies returns ("greedy" when empty)
+	Strategy string `protobuf:"bytes,15,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// Milliseconds the solver strategy may search for, 1000 when 0
+	// (a search stopped by the budget may differ between runs)
+	TimeBudgetMs int32 `protobuf:"varint,16,opt,name=time_budget_ms,json=timeBudgetMs,proto3" json:"time_budget_ms,omitempty"`
+	// Seed of the randomised parts of planning, the same request and seed give the same plan
+	Seed int64 `protobuf:"varint,17,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Local search run on the generated table to leave fewer fragments and an even load, none when unset
+	Improvement *Improvement `protobuf:"bytes,18,opt,name=improvement,proto3" json:"improvement,omitempty"`
+	// What happens to the todos that don't fit in n_periods, appending periods when unset
+	Overflow OverflowPolicy `protobuf:"varint,19,opt,name=overflow,proto3,enum=planner.OverflowPolicy" json:"overflow,omitempty"`
+	// Length of a block, it has to divide the length of a period
+	BlockLength *TimeLength `protobuf:"bytes,20,opt,name=block_length,json=blockLength,proto3" json:"block_length,omitempty"`
+	// Length of a period
+	PeriodLength *TimeLength `protobuf:"bytes,21,opt,name=period_length,json=periodLength,proto3" json:"period_length,omitempty"`
 }
 
-func (x *Period) Reset() {
-	*x = Period{}
+func (x *PlanRequest) Reset() {
+	*x = PlanRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_planner_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -423,13 +792,13 @@ func (x *Period) Reset() {
 	}
 }
 
-func (x *Period) String() string {
+func (x *PlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Period) ProtoMessage() {}
+func (*PlanRequest) ProtoMessage() {}
 
-func (x *Period) ProtoReflect() protoreflect.Message {
+func (x *PlanRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_planner_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -441,35 +810,1595 @@ func (x *Period) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Period.ProtoReflect.Descriptor instead.
-func (*Period) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlanRequest.ProtoReflect.Descriptor instead.
+func (*PlanRequest) Descriptor() ([]byte, []int) {
 	return file_proto_planner_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Period) GetCells() []*TableCell {
+func (x *PlanRequest) GetBuildUnit() string {
 	if x != nil {
-		return x.Cells
+		return x.BuildUnit
 	}
-	return nil
+	return ""
 }
 
-type TimeConstraintsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *PlanRequest) GetPeriodUnit() string {
+	if x != nil {
+		return x.PeriodUnit
+	}
+	return ""
+}
 
-	// Tasks to consider for time constraints calculation
-	Tasks []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	// Routines to consider for time constraints calculation
+func (x *PlanRequest) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetRoutines() []*Routine {
+	if x != nil {
+		return x.Routines
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetNPeriods() int32 {
+	if x != nil {
+		return x.NPeriods
+	}
+	return 0
+}
+
+func (x *PlanRequest) GetNBlocks() int32 {
+	if x != nil {
+		return x.NBlocks
+	}
+	return 0
+}
+
+func (x *PlanRequest) GetPeriodCapacities() []int32 {
+	if x != nil {
+		return x.PeriodCapacities
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetBlockedPeriods() []int32 {
+	if x != nil {
+		return x.BlockedPeriods
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetBlockedSlots() []*Slot {
+	if x != nil {
+		return x.BlockedSlots
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetStartWeekday() string {
+	if x != nil {
+		return x.StartWeekday
+	}
+	return ""
+}
+
+func (x *PlanRequest) GetStartDatetime() string {
+	if x != nil {
+		return x.StartDatetime
+	}
+	return ""
+}
+
+func (x *PlanRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *PlanRequest) GetBlockStartTimes() []string {
+	if x != nil {
+		return x.BlockStartTimes
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetSkippedWeekdays() []string {
+	if x != nil {
+		return x.SkippedWeekdays
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *PlanRequest) GetTimeBudgetMs() int32 {
+	if x != nil {
+		return x.TimeBudgetMs
+	}
+	return 0
+}
+
+func (x *PlanRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *PlanRequest) GetImprovement() *Improvement {
+	if x != nil {
+		return x.Improvement
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetOverflow() OverflowPolicy {
+	if x != nil {
+		return x.Overflow
+	}
+	return OverflowPolicy_OVERFLOW_POLICY_GROW
+}
+
+func (x *PlanRequest) GetBlockLength() *TimeLength {
+	if x != nil {
+		return x.BlockLength
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetPeriodLength() *TimeLength {
+	if x != nil {
+		return x.PeriodLength
+	}
+	return nil
+}
+
+type Improvement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Moves to try, 2000 when 0
+	Iterations int32 `protobuf:"varint,1,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	// Milliseconds the search may take, no limit when 0 (a search stopped by it may differ between runs)
+	TimeLimitMs int32 `protobuf:"varint,2,opt,name=time_limit_ms,json=timeLimitMs,proto3" json:"time_limit_ms,omitempty"`
+}
+
+func (x *Improvement) Reset() {
+	*x = Improvement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Improvement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Improvement) ProtoMessage() {}
+
+func (x *Improvement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Improvement.ProtoReflect.Descriptor instead.
+func (*Improvement) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Improvement) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *Improvement) GetTimeLimitMs() int32 {
+	if x != nil {
+		return x.TimeLimitMs
+	}
+	return 0
+}
+
+type PlanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Periods   []*Period `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"`
+	TotalTime string    `protobuf:"bytes,2,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
+	// Periods whose capacity couldn't hold all the routines
+	ExceededPeriods []int32      `protobuf:"varint,3,rep,packed,name=exceeded_periods,json=exceededPeriods,proto3" json:"exceeded_periods,omitempty"`
+	Metrics         *PlanMetrics `protobuf:"bytes,4,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// Todos the plan doesn't hold all the blocks of, tasks only when the
+	// overflow policy is OVERFLOW_POLICY_BEST_EFFORT
+	Unscheduled []*UnscheduledTodo `protobuf:"bytes,5,rep,name=unscheduled,proto3" json:"unscheduled,omitempty"`
+	// Periods (0-based) added after the requested n_periods to make room for tasks,
+	// none unless the overflow policy is OVERFLOW_POLICY_GROW
+	AppendedPeriods []int32 `protobuf:"varint,6,rep,packed,name=appended_periods,json=appendedPeriods,proto3" json:"appended_periods,omitempty"`
+}
+
+func (x *PlanResponse) Reset() {
+	*x = PlanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanResponse) ProtoMessage() {}
+
+func (x *PlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanResponse.ProtoReflect.Descriptor instead.
+func (*PlanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlanResponse) GetPeriods() []*Period {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *PlanResponse) GetTotalTime() string {
+	if x != nil {
+		return x.TotalTime
+	}
+	return ""
+}
+
+func (x *PlanResponse) GetExceededPeriods() []int32 {
+	if x != nil {
+		return x.ExceededPeriods
+	}
+	return nil
+}
+
+func (x *PlanResponse) GetMetrics() *PlanMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *PlanResponse) GetUnscheduled() []*UnscheduledTodo {
+	if x != nil {
+		return x.Unscheduled
+	}
+	return nil
+}
+
+func (x *PlanResponse) GetAppendedPeriods() []int32 {
+	if x != nil {
+		return x.AppendedPeriods
+	}
+	return nil
+}
+
+// A todo left partly or wholly out of a plan
+type UnscheduledTodo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "task" or "routine"
+	Type   string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	TodoId string `protobuf:"bytes,2,opt,name=todo_id,json=todoId,proto3" json:"todo_id,omitempty"`
+	// Blocks of the todo missing from the plan
+	MissingBlocks int32 `protobuf:"varint,3,opt,name=missing_blocks,json=missingBlocks,proto3" json:"missing_blocks,omitempty"`
+	// Why they couldn't be placed
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Periods (0-based) a routine is missing from
+	Periods []int32 `protobuf:"varint,5,rep,packed,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (x *UnscheduledTodo) Reset() {
+	*x = UnscheduledTodo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnscheduledTodo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnscheduledTodo) ProtoMessage() {}
+
+func (x *UnscheduledTodo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnscheduledTodo.ProtoReflect.Descriptor instead.
+func (*UnscheduledTodo) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnscheduledTodo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UnscheduledTodo) GetTodoId() string {
+	if x != nil {
+		return x.TodoId
+	}
+	return ""
+}
+
+func (x *UnscheduledTodo) GetMissingBlocks() int32 {
+	if x != nil {
+		return x.MissingBlocks
+	}
+	return 0
+}
+
+func (x *UnscheduledTodo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UnscheduledTodo) GetPeriods() []int32 {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+// The shape of a plan, to compare plans and explain them
+type PlanMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Used share (0 to 1) of the capacity of every period, 0 for blocked periods
+	Utilisation []float64 `protobuf:"fixed64,1,rep,packed,name=utilisation,proto3" json:"utilisation,omitempty"`
+	// Variance of the utilisation of the periods with capacity, 0 when the load is even
+	LoadVariance float64 `protobuf:"fixed64,2,opt,name=load_variance,json=loadVariance,proto3" json:"load_variance,omitempty"`
+	// Runs of consecutive blocks of the same task
+	Fragments int32 `protobuf:"varint,3,opt,name=fragments,proto3" json:"fragments,omitempty"`
+	// Changes from a todo to another in every period
+	ContextSwitches []int32 `protobuf:"varint,4,rep,packed,name=context_switches,json=contextSwitches,proto3" json:"context_switches,omitempty"`
+	// Last period holding tasks of the highest priority, -1 when there are none
+	HighPriorityPeriod int32 `protobuf:"varint,5,opt,name=high_priority_period,json=highPriorityPeriod,proto3" json:"high_priority_period,omitempty"`
+	// Share (0 to 1) of the plan gone when the tasks of the highest priority are done
+	HighPriorityFinish float64 `protobuf:"fixed64,6,opt,name=high_priority_finish,json=highPriorityFinish,proto3" json:"high_priority_finish,omitempty"`
+	// Blocks that could hold a todo and hold none
+	UnusedCapacity int32 `protobuf:"varint,7,opt,name=unused_capacity,json=unusedCapacity,proto3" json:"unused_capacity,omitempty"`
+	// 0 to 100, higher is better: even load, few fragments and context switches, urgent work first
+	Score float64 `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *PlanMetrics) Reset() {
+	*x = PlanMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanMetrics) ProtoMessage() {}
+
+func (x *PlanMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanMetrics.ProtoReflect.Descriptor instead.
+func (*PlanMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PlanMetrics) GetUtilisation() []float64 {
+	if x != nil {
+		return x.Utilisation
+	}
+	return nil
+}
+
+func (x *PlanMetrics) GetLoadVariance() float64 {
+	if x != nil {
+		return x.LoadVariance
+	}
+	return 0
+}
+
+func (x *PlanMetrics) GetFragments() int32 {
+	if x != nil {
+		return x.Fragments
+	}
+	return 0
+}
+
+func (x *PlanMetrics) GetContextSwitches() []int32 {
+	if x != nil {
+		return x.ContextSwitches
+	}
+	return nil
+}
+
+func (x *PlanMetrics) GetHighPriorityPeriod() int32 {
+	if x != nil {
+		return x.HighPriorityPeriod
+	}
+	return 0
+}
+
+func (x *PlanMetrics) GetHighPriorityFinish() float64 {
+	if x != nil {
+		return x.HighPriorityFinish
+	}
+	return 0
+}
+
+func (x *PlanMetrics) GetUnusedCapacity() int32 {
+	if x != nil {
+		return x.UnusedCapacity
+	}
+	return 0
+}
+
+func (x *PlanMetrics) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type PlanAlternativesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The plan to generate alternatives of, its strategy, seed and improvement are varied
+	Plan *PlanRequest `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	// Most plans to return, 3 when 0
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Milliseconds every plan is generated in, plans not done by then are left out, 2000 when 0
+	DeadlineMs int32 `protobuf:"varint,3,opt,name=deadline_ms,json=deadlineMs,proto3" json:"deadline_ms,omitempty"`
+}
+
+func (x *PlanAlternativesRequest) Reset() {
+	*x = PlanAlternativesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanAlternativesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanAlternativesRequest) ProtoMessage() {}
+
+func (x *PlanAlternativesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanAlternativesRequest.ProtoReflect.Descriptor instead.
+func (*PlanAlternativesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PlanAlternativesRequest) GetPlan() *PlanRequest {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *PlanAlternativesRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PlanAlternativesRequest) GetDeadlineMs() int32 {
+	if x != nil {
+		return x.DeadlineMs
+	}
+	return 0
+}
+
+type PlanAlternativesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Distinct plans, the best score first
+	Alternatives []*PlanAlternative `protobuf:"bytes,1,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+}
+
+func (x *PlanAlternativesResponse) Reset() {
+	*x = PlanAlternativesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanAlternativesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanAlternativesResponse) ProtoMessage() {}
+
+func (x *PlanAlternativesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanAlternativesResponse.ProtoReflect.Descriptor instead.
+func (*PlanAlternativesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PlanAlternativesResponse) GetAlternatives() []*PlanAlternative {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+type PlanAlternative struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plan *PlanResponse `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	// How the plan differs from the others (e.g. "front-loaded", "balanced", "fewest fragments")
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The strategy, seed and improvement the plan was generated with
+	Strategy string `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Seed     int64  `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
+	Improved bool   `protobuf:"varint,5,opt,name=improved,proto3" json:"improved,omitempty"`
+	// The score of the plan's metrics
+	Score float64 `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *PlanAlternative) Reset() {
+	*x = PlanAlternative{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanAlternative) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanAlternative) ProtoMessage() {}
+
+func (x *PlanAlternative) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanAlternative.ProtoReflect.Descriptor instead.
+func (*PlanAlternative) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PlanAlternative) GetPlan() *PlanResponse {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *PlanAlternative) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PlanAlternative) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *PlanAlternative) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *PlanAlternative) GetImproved() bool {
+	if x != nil {
+		return x.Improved
+	}
+	return false
+}
+
+func (x *PlanAlternative) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type Period struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cells []*TableCell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	// RFC 3339 datetime the period starts at, set when the plan has a start_datetime
+	Start string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+}
+
+func (x *Period) Reset() {
+	*x = Period{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Period) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Period) ProtoMessage() {}
+
+func (x *Period) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Period.ProtoReflect.Descriptor instead.
+func (*Period) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Period) GetCells() []*TableCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *Period) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+type ReplanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The plan to fill, with the full required time of every todo
+	Plan *PlanRequest `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	// The previous table of the plan
+	Periods []*Period `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+	// Cells of the previous table to keep in their slots (completed or locked by the user)
+	Pins []*Slot `protobuf:"bytes,3,rep,name=pins,proto3" json:"pins,omitempty"`
+}
+
+func (x *ReplanRequest) Reset() {
+	*x = ReplanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplanRequest) ProtoMessage() {}
+
+func (x *ReplanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplanRequest.ProtoReflect.Descriptor instead.
+func (*ReplanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReplanRequest) GetPlan() *PlanRequest {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *ReplanRequest) GetPeriods() []*Period {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *ReplanRequest) GetPins() []*Slot {
+	if x != nil {
+		return x.Pins
+	}
+	return nil
+}
+
+type ExportCalendarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The plan to export, it needs a start_datetime
+	Plan *PlanRequest `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	// The table of the plan (e.g. after ReplanPlan), generated from plan when empty
+	Periods []*Period `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (x *ExportCalendarRequest) Reset() {
+	*x = ExportCalendarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCalendarRequest) ProtoMessage() {}
+
+func (x *ExportCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCalendarRequest.ProtoReflect.Descriptor instead.
+func (*ExportCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportCalendarRequest) GetPlan() *PlanRequest {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *ExportCalendarRequest) GetPeriods() []*Period {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type ExportPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The plan to export
+	Plan *PlanRequest `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	// The table of the plan (e.g. after ReplanPlan), generated from plan when empty
+	Periods []*Period    `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+	Format  ExportFormat `protobuf:"varint,3,opt,name=format,proto3,enum=planner.ExportFormat" json:"format,omitempty"`
+}
+
+func (x *ExportPlanRequest) Reset() {
+	*x = ExportPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPlanRequest) ProtoMessage() {}
+
+func (x *ExportPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPlanRequest.ProtoReflect.Descriptor instead.
+func (*ExportPlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExportPlanRequest) GetPlan() *PlanRequest {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *ExportPlanRequest) GetPeriods() []*Period {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *ExportPlanRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+type ExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The exported document
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Media type of the document (e.g. "text/calendar; charset=utf-8")
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Suggested name of the file
+	FileName string `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+}
+
+func (x *ExportResponse) Reset() {
+	*x = ExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResponse) ProtoMessage() {}
+
+func (x *ExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResponse.ProtoReflect.Descriptor instead.
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExportResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ExportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type ImportTodosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The document to import
+	Content string       `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Format  ImportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=planner.ImportFormat" json:"format,omitempty"`
+	// Unit the required times of the todos are counted in, as in PlanRequest,
+	// set block_length instead
+	BuildUnit string `protobuf:"bytes,3,opt,name=build_unit,json=buildUnit,proto3" json:"build_unit,omitempty"`
+	// Length of the blocks the required times of the todos are counted in
+	BlockLength *TimeLength `protobuf:"bytes,4,opt,name=block_length,json=blockLength,proto3" json:"block_length,omitempty"`
+}
+
+func (x *ImportTodosRequest) Reset() {
+	*x = ImportTodosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTodosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTodosRequest) ProtoMessage() {}
+
+func (x *ImportTodosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTodosRequest.ProtoReflect.Descriptor instead.
+func (*ImportTodosRequest) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ImportTodosRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ImportTodosRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportTodosRequest) GetBuildUnit() string {
+	if x != nil {
+		return x.BuildUnit
+	}
+	return ""
+}
+
+func (x *ImportTodosRequest) GetBlockLength() *TimeLength {
+	if x != nil {
+		return x.BlockLength
+	}
+	return nil
+}
+
+type ImportTodosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Todos keep the id of the document, those without one get an id from
+	// their line and title ("line-3-5d41402a"), the same on every import
+	Tasks    []*Task    `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Routines []*Routine `protobuf:"bytes,2,rep,name=routines,proto3" json:"routines,omitempty"`
+	// Todos of the document that couldn't be read, the others are still imported
+	Errors []*ImportError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ImportTodosResponse) Reset() {
+	*x = ImportTodosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTodosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTodosResponse) ProtoMessage() {}
+
+func (x *ImportTodosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTodosResponse.ProtoReflect.Descriptor instead.
+func (*ImportTodosResponse) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportTodosResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ImportTodosResponse) GetRoutines() []*Routine {
+	if x != nil {
+		return x.Routines
+	}
+	return nil
+}
+
+func (x *ImportTodosResponse) GetErrors() []*ImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ImportError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Line of the document, 0 when it isn't known
+	Line    int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ImportError) Reset() {
+	*x = ImportError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportError) ProtoMessage() {}
+
+func (x *ImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportError.ProtoReflect.Descriptor instead.
+func (*ImportError) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListStrategiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListStrategiesRequest) Reset() {
+	*x = ListStrategiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStrategiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStrategiesRequest) ProtoMessage() {}
+
+func (x *ListStrategiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStrategiesRequest.ProtoReflect.Descriptor instead.
+func (*ListStrategiesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{22}
+}
+
+type ListStrategiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strategies []*Strategy `protobuf:"bytes,1,rep,name=strategies,proto3" json:"strategies,omitempty"`
+}
+
+func (x *ListStrategiesResponse) Reset() {
+	*x = ListStrategiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStrategiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStrategiesResponse) ProtoMessage() {}
+
+func (x *ListStrategiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStrategiesResponse.ProtoReflect.Descriptor instead.
+func (*ListStrategiesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListStrategiesResponse) GetStrategies() []*Strategy {
+	if x != nil {
+		return x.Strategies
+	}
+	return nil
+}
+
+type Strategy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name to set as the strategy of a PlanRequest
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *Strategy) Reset() {
+	*x = Strategy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Strategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Strategy) ProtoMessage() {}
+
+func (x *Strategy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Strategy.ProtoReflect.Descriptor instead.
+func (*Strategy) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Strategy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Strategy) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Sent as a status detail when GeneratePlan or ReplanPlan fail with
+// "invalid plan parameters"
+type PlanDiagnostics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Infeasibilities []*Infeasibility `protobuf:"bytes,1,rep,name=infeasibilities,proto3" json:"infeasibilities,omitempty"`
+}
+
+func (x *PlanDiagnostics) Reset() {
+	*x = PlanDiagnostics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanDiagnostics) ProtoMessage() {}
+
+func (x *PlanDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanDiagnostics.ProtoReflect.Descriptor instead.
+func (*PlanDiagnostics) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PlanDiagnostics) GetInfeasibilities() []*Infeasibility {
+	if x != nil {
+		return x.Infeasibilities
+	}
+	return nil
+}
+
+type Infeasibility struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "routines_exceed_blocks", "tasks_exceed_capacity", "unbreakable_task_too_long",
+	// "invalid_task_window", "task_window_overloaded", "chunk_too_large",
+	// "routine_times_exceed_plan", or "todo_does_not_fit" when a strict plan
+	// can't place a todo in the requested periods
+	Constraint  string `protobuf:"bytes,1,opt,name=constraint,proto3" json:"constraint,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Todos involved in the failure, if any
+	TodoIds []string `protobuf:"bytes,3,rep,name=todo_ids,json=todoIds,proto3" json:"todo_ids,omitempty"`
+	// Range of periods involved in the failure (0-based, inclusive)
+	FirstPeriod int32 `protobuf:"varint,4,opt,name=first_period,json=firstPeriod,proto3" json:"first_period,omitempty"`
+	LastPeriod  int32 `protobuf:"varint,5,opt,name=last_period,json=lastPeriod,proto3" json:"last_period,omitempty"`
+	// Blocks missing to satisfy the constraint
+	Shortfall int32           `protobuf:"varint,6,opt,name=shortfall,proto3" json:"shortfall,omitempty"`
+	Fixes     []*SuggestedFix `protobuf:"bytes,7,rep,name=fixes,proto3" json:"fixes,omitempty"`
+}
+
+func (x *Infeasibility) Reset() {
+	*x = Infeasibility{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Infeasibility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Infeasibility) ProtoMessage() {}
+
+func (x *Infeasibility) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Infeasibility.ProtoReflect.Descriptor instead.
+func (*Infeasibility) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Infeasibility) GetConstraint() string {
+	if x != nil {
+		return x.Constraint
+	}
+	return ""
+}
+
+func (x *Infeasibility) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Infeasibility) GetTodoIds() []string {
+	if x != nil {
+		return x.TodoIds
+	}
+	return nil
+}
+
+func (x *Infeasibility) GetFirstPeriod() int32 {
+	if x != nil {
+		return x.FirstPeriod
+	}
+	return 0
+}
+
+func (x *Infeasibility) GetLastPeriod() int32 {
+	if x != nil {
+		return x.LastPeriod
+	}
+	return 0
+}
+
+func (x *Infeasibility) GetShortfall() int32 {
+	if x != nil {
+		return x.Shortfall
+	}
+	return 0
+}
+
+func (x *Infeasibility) GetFixes() []*SuggestedFix {
+	if x != nil {
+		return x.Fixes
+	}
+	return nil
+}
+
+type SuggestedFix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Request field to change, "n_periods" or "n_blocks"
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Smallest value of the field that satisfies the constraint
+	Value       int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *SuggestedFix) Reset() {
+	*x = SuggestedFix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_planner_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestedFix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestedFix) ProtoMessage() {}
+
+func (x *SuggestedFix) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_planner_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestedFix.ProtoReflect.Descriptor instead.
+func (*SuggestedFix) Descriptor() ([]byte, []int) {
+	return file_proto_planner_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SuggestedFix) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SuggestedFix) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SuggestedFix) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type TimeConstraintsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Tasks to consider for time constraints calculation
+	Tasks []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// Routines to consider for time constraints calculation
 	Routines []*Routine `protobuf:"bytes,2,rep,name=routines,proto3" json:"routines,omitempty"`
-	// The unit for blocks (e.g., "hour", "day")
+	// The unit for blocks (e.g., "hour", "day"), set block_length instead
 	BlocksUnit string `protobuf:"bytes,3,opt,name=blocks_unit,json=blocksUnit,proto3" json:"blocks_unit,omitempty"`
+	// The unit for periods, the calendar unit after the block's when empty
+	// (a day for blocks of hours), set period_length instead
+	PeriodUnit string `protobuf:"bytes,4,opt,name=period_unit,json=periodUnit,proto3" json:"period_unit,omitempty"`
+	// Length of a block, it has to divide the length of a period when one is given
+	BlockLength *TimeLength `protobuf:"bytes,5,opt,name=block_length,json=blockLength,proto3" json:"block_length,omitempty"`
+	// Length of a period
+	PeriodLength *TimeLength `protobuf:"bytes,6,opt,name=period_length,json=periodLength,proto3" json:"period_length,omitempty"`
 }
 
 func (x *TimeConstraintsRequest) Reset() {
 	*x = TimeConstraintsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_planner_proto_msgTypes[7]
+		mi := &file_proto_planner_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -482,7 +2411,7 @@ func (x *TimeConstraintsRequest) String() string {
 func (*TimeConstraintsRequest) ProtoMessage() {}
 
 func (x *TimeConstraintsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_planner_proto_msgTypes[7]
+	mi := &file_proto_planner_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -495,7 +2424,7 @@ func (x *TimeConstraintsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeConstraintsRequest.ProtoReflect.Descriptor instead.
 func (*TimeConstraintsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_planner_proto_rawDescGZIP(), []int{7}
+	return file_proto_planner_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *TimeConstraintsRequest) GetTasks() []*Task {
@@ -519,6 +2448,27 @@ func (x *TimeConstraintsRequest) GetBlocksUnit() string {
 	return ""
 }
 
+func (x *TimeConstraintsRequest) GetPeriodUnit() string {
+	if x != nil {
+		return x.PeriodUnit
+	}
+	return ""
+}
+
+func (x *TimeConstraintsRequest) GetBlockLength() *TimeLength {
+	if x != nil {
+		return x.BlockLength
+	}
+	return nil
+}
+
+func (x *TimeConstraintsRequest) GetPeriodLength() *TimeLength {
+	if x != nil {
+		return x.PeriodLength
+	}
+	return nil
+}
+
 type TimeConstraintsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -533,7 +2483,7 @@ type TimeConstraintsResponse struct {
 func (x *TimeConstraintsResponse) Reset() {
 	*x = TimeConstraintsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_planner_proto_msgTypes[8]
+		mi := &file_proto_planner_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -546,7 +2496,7 @@ func (x *TimeConstraintsResponse) String() string {
 func (*TimeConstraintsResponse) ProtoMessage() {}
 
 func (x *TimeConstraintsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_planner_proto_msgTypes[8]
+	mi := &file_proto_planner_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,7 +2509,7 @@ func (x *TimeConstraintsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeConstraintsResponse.ProtoReflect.Descriptor instead.
 func (*TimeConstraintsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_planner_proto_rawDescGZIP(), []int{8}
+	return file_proto_planner_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *TimeConstraintsResponse) GetLeastBlocks() int32 {
@@ -603,74 +2553,387 @@ var file_proto_planner_proto_rawDesc = []byte{
 	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
 	0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x68, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b,
-	0x12, 0x21, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x04, 0x74,
-	0x6f, 0x64, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
-	0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x61, 0x62,
-	0x6c, 0x65, 0x22, 0x2c, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x12, 0x21, 0x0a,
-	0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x04, 0x74, 0x6f, 0x64, 0x6f,
-	0x22, 0x38, 0x0a, 0x09, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x64, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x64, 0x6f, 0x49, 0x64, 0x22, 0xd8, 0x01, 0x0a, 0x0b, 0x50,
-	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x61,
-	0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12,
-	0x2c, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74,
-	0x69, 0x6e, 0x65, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1b, 0x0a,
-	0x09, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x58, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
-	0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x22,
-	0x32, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x63, 0x65, 0x6c,
-	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x65, 0x72, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65,
-	0x6c, 0x6c, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x16, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x73,
-	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xb5, 0x02, 0x0a, 0x04, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x04,
+	0x74, 0x6f, 0x64, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x73, 0x69, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x0e, 0x65, 0x61,
+	0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x01, 0x52, 0x0d, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x22, 0xc0, 0x01, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x04,
+	0x74, 0x6f, 0x64, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x64, 0x6f, 0x52, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x22, 0x78, 0x0a, 0x09, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x65, 0x6c, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x64, 0x6f, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x64, 0x6f, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70,
+	0x69, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x34, 0x0a,
+	0x04, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x22, 0x49, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x25, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e,
+	0x69, 0x74, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd7,
+	0x06, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x23,
+	0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
 	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61,
 	0x73, 0x6b, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
 	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x75, 0x6e, 0x69, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x55, 0x6e,
-	0x69, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x17, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
-	0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x32, 0xaa, 0x01, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x6e, 0x6e,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x15, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1f,
-	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e,
-	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6f,
-	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x10, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12,
+	0x32, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x53, 0x6c,
+	0x6f, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x77, 0x65, 0x65,
+	0x6b, 0x64, 0x61, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x2a, 0x0a, 0x11,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x57, 0x65, 0x65, 0x6b, 0x64,
+	0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x24, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x6d,
+	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x4d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0b, 0x69, 0x6d, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x33, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4f, 0x76,
+	0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x6f, 0x76,
+	0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x36, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x38,
+	0x0a, 0x0d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x0c, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x51, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x74, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x22, 0x9a, 0x02, 0x0a, 0x0c,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x07,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64,
+	0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0f, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x55, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x6f, 0x64, 0x6f,
+	0x52, 0x0b, 0x75, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a,
+	0x10, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x0f, 0x55, 0x6e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x6f, 0x64, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x64, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x6f, 0x64, 0x6f, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x61,
+	0x64, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x61,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x72,
+	0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x12, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x12, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64,
+	0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x7a, 0x0a, 0x17, 0x50, 0x6c, 0x61, 0x6e, 0x41, 0x6c, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x28, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x4d,
+	0x73, 0x22, 0x58, 0x0a, 0x18, 0x50, 0x6c, 0x61, 0x6e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c,
+	0x61, 0x6e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x0c, 0x61,
+	0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x0f,
+	0x50, 0x6c, 0x61, 0x6e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12,
+	0x29, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x48,
+	0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x6c,
+	0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04,
+	0x70, 0x6c, 0x61, 0x6e, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12,
+	0x21, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x70, 0x69,
+	0x6e, 0x73, 0x22, 0x6c, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x70,
+	0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x22, 0x97, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e,
+	0x12, 0x29, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x6a, 0x0a, 0x0e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x54, 0x6f, 0x64, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f,
+	0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x96, 0x01,
+	0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x64, 0x6f, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x52, 0x08,
+	0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x0a, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x08, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x0f, 0x50,
+	0x6c, 0x61, 0x6e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x40,
+	0x0a, 0x0f, 0x69, 0x6e, 0x66, 0x65, 0x61, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x61, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x0f, 0x69, 0x6e, 0x66, 0x65, 0x61, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x22, 0xfb, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x66, 0x65, 0x61, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x64, 0x6f, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x64, 0x6f, 0x49, 0x64, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x66, 0x61, 0x6c, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x66, 0x61, 0x6c,
+	0x6c, 0x12, 0x2b, 0x0a, 0x05, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x52, 0x05, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x5c,
+	0x0a, 0x0c, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9f, 0x02, 0x0a,
+	0x16, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x2c, 0x0a, 0x08,
+	0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65,
+	0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x38, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x52, 0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xa1,
+	0x01, 0x0a, 0x17, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x65,
+	0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x6c, 0x65, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x73, 0x2a, 0x8b, 0x01, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12,
+	0x19, 0x0a, 0x15, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x10, 0x01,
+	0x12, 0x12, 0x0a, 0x0e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x48, 0x4f,
+	0x55, 0x52, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x49,
+	0x54, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x49, 0x4d, 0x45, 0x5f,
+	0x55, 0x4e, 0x49, 0x54, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x54,
+	0x49, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x05,
+	0x2a, 0x67, 0x0a, 0x0e, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x56, 0x45, 0x52, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x4f, 0x56, 0x45, 0x52, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f,
+	0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x56, 0x45, 0x52,
+	0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x42, 0x45, 0x53, 0x54,
+	0x5f, 0x45, 0x46, 0x46, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x2a, 0x8f, 0x01, 0x0a, 0x0c, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01,
+	0x12, 0x1a, 0x0a, 0x16, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12,
+	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x48, 0x54,
+	0x4d, 0x4c, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x49, 0x43, 0x53, 0x10, 0x04, 0x2a, 0x7b, 0x0a, 0x0c, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x49,
+	0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4d,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10,
+	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x19, 0x0a,
+	0x15, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54,
+	0x4f, 0x44, 0x4f, 0x49, 0x53, 0x54, 0x10, 0x03, 0x32, 0xff, 0x04, 0x0a, 0x0e, 0x50, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x14, 0x2e, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x1f, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x6e, 0x50,
+	0x6c, 0x61, 0x6e, 0x12, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x50, 0x6c, 0x61, 0x6e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73,
+	0x12, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x41,
+	0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x1e, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x1a, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x54, 0x6f, 0x64, 0x6f, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x64, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x64, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -685,36 +2948,103 @@ func file_proto_planner_proto_rawDescGZIP() []byte {
 	return file_proto_planner_proto_rawDescData
 }
 
-var file_proto_planner_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_planner_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_planner_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_proto_planner_proto_goTypes = []interface{}{
-	(*Todo)(nil),                    // 0: planner.Todo
-	(*Task)(nil),                    // 1: planner.Task
-	(*Routine)(nil),                 // 2: planner.Routine
-	(*TableCell)(nil),               // 3: planner.TableCell
-	(*PlanRequest)(nil),             // 4: planner.PlanRequest
-	(*PlanResponse)(nil),            // 5: planner.PlanResponse
-	(*Period)(nil),                  // 6: planner.Period
-	(*TimeConstraintsRequest)(nil),  // 7: planner.TimeConstraintsRequest
-	(*TimeConstraintsResponse)(nil), // 8: planner.TimeConstraintsResponse
+	(TimeUnit)(0),                    // 0: planner.TimeUnit
+	(OverflowPolicy)(0),              // 1: planner.OverflowPolicy
+	(ExportFormat)(0),                // 2: planner.ExportFormat
+	(ImportFormat)(0),                // 3: planner.ImportFormat
+	(*Todo)(nil),                     // 4: planner.Todo
+	(*Task)(nil),                     // 5: planner.Task
+	(*Routine)(nil),                  // 6: planner.Routine
+	(*TableCell)(nil),                // 7: planner.TableCell
+	(*Slot)(nil),                     // 8: planner.Slot
+	(*TimeLength)(nil),               // 9: planner.TimeLength
+	(*PlanRequest)(nil),              // 10: planner.PlanRequest
+	(*Improvement)(nil),              // 11: planner.Improvement
+	(*PlanResponse)(nil),             // 12: planner.PlanResponse
+	(*UnscheduledTodo)(nil),          // 13: planner.UnscheduledTodo
+	(*PlanMetrics)(nil),              // 14: planner.PlanMetrics
+	(*PlanAlternativesRequest)(nil),  // 15: planner.PlanAlternativesRequest
+	(*PlanAlternativesResponse)(nil), // 16: planner.PlanAlternativesResponse
+	(*PlanAlternative)(nil),          // 17: planner.PlanAlternative
+	(*Period)(nil),                   // 18: planner.Period
+	(*ReplanRequest)(nil),            // 19: planner.ReplanRequest
+	(*ExportCalendarRequest)(nil),    // 20: planner.ExportCalendarRequest
+	(*ExportPlanRequest)(nil),        // 21: planner.ExportPlanRequest
+	(*ExportResponse)(nil),           // 22: planner.ExportResponse
+	(*ImportTodosRequest)(nil),       // 23: planner.ImportTodosRequest
+	(*ImportTodosResponse)(nil),      // 24: planner.ImportTodosResponse
+	(*ImportError)(nil),              // 25: planner.ImportError
+	(*ListStrategiesRequest)(nil),    // 26: planner.ListStrategiesRequest
+	(*ListStrategiesResponse)(nil),   // 27: planner.ListStrategiesResponse
+	(*Strategy)(nil),                 // 28: planner.Strategy
+	(*PlanDiagnostics)(nil),          // 29: planner.PlanDiagnostics
+	(*Infeasibility)(nil),            // 30: planner.Infeasibility
+	(*SuggestedFix)(nil),             // 31: planner.SuggestedFix
+	(*TimeConstraintsRequest)(nil),   // 32: planner.TimeConstraintsRequest
+	(*TimeConstraintsResponse)(nil),  // 33: planner.TimeConstraintsResponse
 }
 var file_proto_planner_proto_depIdxs = []int32{
-	0,  // 0: planner.Task.todo:type_name -> planner.Todo
-	0,  // 1: planner.Routine.todo:type_name -> planner.Todo
-	1,  // 2: planner.PlanRequest.tasks:type_name -> planner.Task
-	2,  // 3: planner.PlanRequest.routines:type_name -> planner.Routine
-	6,  // 4: planner.PlanResponse.periods:type_name -> planner.Period
-	3,  // 5: planner.Period.cells:type_name -> planner.TableCell
-	1,  // 6: planner.TimeConstraintsRequest.tasks:type_name -> planner.Task
-	2,  // 7: planner.TimeConstraintsRequest.routines:type_name -> planner.Routine
-	4,  // 8: planner.PlannerService.GeneratePlan:input_type -> planner.PlanRequest
-	7,  // 9: planner.PlannerService.GetTimeConstraints:input_type -> planner.TimeConstraintsRequest
-	5,  // 10: planner.PlannerService.GeneratePlan:output_type -> planner.PlanResponse
-	8,  // 11: planner.PlannerService.GetTimeConstraints:output_type -> planner.TimeConstraintsResponse
-	10, // [10:12] is the sub-list for method output_type
-	8,  // [8:10] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	4,  // 0: planner.Task.todo:type_name -> planner.Todo
+	4,  // 1: planner.Routine.todo:type_name -> planner.Todo
+	0,  // 2: planner.TimeLength.unit:type_name -> planner.TimeUnit
+	5,  // 3: planner.PlanRequest.tasks:type_name -> planner.Task
+	6,  // 4: planner.PlanRequest.routines:type_name -> planner.Routine
+	8,  // 5: planner.PlanRequest.blocked_slots:type_name -> planner.Slot
+	11, // 6: planner.PlanRequest.improvement:type_name -> planner.Improvement
+	1,  // 7: planner.PlanRequest.overflow:type_name -> planner.OverflowPolicy
+	9,  // 8: planner.PlanRequest.block_length:type_name -> planner.TimeLength
+	9,  // 9: planner.PlanRequest.period_length:type_name -> planner.TimeLength
+	18, // 10: planner.PlanResponse.periods:type_name -> planner.Period
+	14, // 11: planner.PlanResponse.metrics:type_name -> planner.PlanMetrics
+	13, // 12: planner.PlanResponse.unscheduled:type_name -> planner.UnscheduledTodo
+	10, // 13: planner.PlanAlternativesRequest.plan:type_name -> planner.PlanRequest
+	17, // 14: planner.PlanAlternativesResponse.alternatives:type_name -> planner.PlanAlternative
+	12, // 15: planner.PlanAlternative.plan:type_name -> planner.PlanResponse
+	7,  // 16: planner.Period.cells:type_name -> planner.TableCell
+	10, // 17: planner.ReplanRequest.plan:type_name -> planner.PlanRequest
+	18, // 18: planner.ReplanRequest.periods:type_name -> planner.Period
+	8,  // 19: planner.ReplanRequest.pins:type_name -> planner.Slot
+	10, // 20: planner.ExportCalendarRequest.plan:type_name -> planner.PlanRequest
+	18, // 21: planner.ExportCalendarRequest.periods:type_name -> planner.Period
+	10, // 22: planner.ExportPlanRequest.plan:type_name -> planner.PlanRequest
+	18, // 23: planner.ExportPlanRequest.periods:type_name -> planner.Period
+	2,  // 24: planner.ExportPlanRequest.format:type_name -> planner.ExportFormat
+	3,  // 25: planner.ImportTodosRequest.format:type_name -> planner.ImportFormat
+	9,  // 26: planner.ImportTodosRequest.block_length:type_name -> planner.TimeLength
+	5,  // 27: planner.ImportTodosResponse.tasks:type_name -> planner.Task
+	6,  // 28: planner.ImportTodosResponse.routines:type_name -> planner.Routine
+	25, // 29: planner.ImportTodosResponse.errors:type_name -> planner.ImportError
+	28, // 30: planner.ListStrategiesResponse.strategies:type_name -> planner.Strategy
+	30, // 31: planner.PlanDiagnostics.infeasibilities:type_name -> planner.Infeasibility
+	31, // 32: planner.Infeasibility.fixes:type_name -> planner.SuggestedFix
+	5,  // 33: planner.TimeConstraintsRequest.tasks:type_name -> planner.Task
+	6,  // 34: planner.TimeConstraintsRequest.routines:type_name -> planner.Routine
+	9,  // 35: planner.TimeConstraintsRequest.block_length:type_name -> planner.TimeLength
+	9,  // 36: planner.TimeConstraintsRequest.period_length:type_name -> planner.TimeLength
+	10, // 37: planner.PlannerService.GeneratePlan:input_type -> planner.PlanRequest
+	32, // 38: planner.PlannerService.GetTimeConstraints:input_type -> planner.TimeConstraintsRequest
+	19, // 39: planner.PlannerService.ReplanPlan:input_type -> planner.ReplanRequest
+	15, // 40: planner.PlannerService.GeneratePlanAlternatives:input_type -> planner.PlanAlternativesRequest
+	20, // 41: planner.PlannerService.ExportCalendar:input_type -> planner.ExportCalendarRequest
+	21, // 42: planner.PlannerService.ExportPlan:input_type -> planner.ExportPlanRequest
+	23, // 43: planner.PlannerService.ImportTodos:input_type -> planner.ImportTodosRequest
+	26, // 44: planner.PlannerService.ListStrategies:input_type -> planner.ListStrategiesRequest
+	12, // 45: planner.PlannerService.GeneratePlan:output_type -> planner.PlanResponse
+	33, // 46: planner.PlannerService.GetTimeConstraints:output_type -> planner.TimeConstraintsResponse
+	12, // 47: planner.PlannerService.ReplanPlan:output_type -> planner.PlanResponse
+	16, // 48: planner.PlannerService.GeneratePlanAlternatives:output_type -> planner.PlanAlternativesResponse
+	22, // 49: planner.PlannerService.ExportCalendar:output_type -> planner.ExportResponse
+	22, // 50: planner.PlannerService.ExportPlan:output_type -> planner.ExportResponse
+	24, // 51: planner.PlannerService.ImportTodos:output_type -> planner.ImportTodosResponse
+	27, // 52: planner.PlannerService.ListStrategies:output_type -> planner.ListStrategiesResponse
+	45, // [45:53] is the sub-list for method output_type
+	37, // [37:45] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_proto_planner_proto_init() }
@@ -772,7 +3102,7 @@ func file_proto_planner_proto_init() {
 			}
 		}
 		file_proto_planner_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlanRequest); i {
+			switch v := v.(*Slot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -784,7 +3114,7 @@ func file_proto_planner_proto_init() {
 			}
 		}
 		file_proto_planner_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlanResponse); i {
+			switch v := v.(*TimeLength); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -796,7 +3126,7 @@ func file_proto_planner_proto_init() {
 			}
 		}
 		file_proto_planner_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Period); i {
+			switch v := v.(*PlanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -808,7 +3138,7 @@ func file_proto_planner_proto_init() {
 			}
 		}
 		file_proto_planner_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimeConstraintsRequest); i {
+			switch v := v.(*Improvement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -820,6 +3150,258 @@ func file_proto_planner_proto_init() {
 			}
 		}
 		file_proto_planner_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnscheduledTodo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanAlternativesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanAlternativesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanAlternative); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Period); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportCalendarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPlanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportTodosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportTodosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStrategiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStrategiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Strategy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanDiagnostics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Infeasibility); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestedFix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeConstraintsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_planner_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TimeConstraintsResponse); i {
 			case 0:
 				return &v.state
@@ -832,18 +3414,20 @@ func file_proto_planner_proto_init() {
 			}
 		}
 	}
+	file_proto_planner_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_planner_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      4,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_planner_proto_goTypes,
 		DependencyIndexes: file_proto_planner_proto_depIdxs,
+		EnumInfos:         file_proto_planner_proto_enumTypes,
 		MessageInfos:      file_proto_planner_proto_msgTypes,
 	}.Build()
 	File_proto_planner_proto = out.File
@@ -24,6 +24,12 @@ const _ = grpc.SupportPackageIsVersion7
 type PlannerServiceClient interface {
 	GeneratePlan(ctx context.Context, in *PlanRequest, opts ...grpc.CallOption) (*PlanResponse, error)
 	GetTimeConstraints(ctx context.Context, in *TimeConstraintsRequest, opts ...grpc.CallOption) (*TimeConstraintsResponse, error)
+	ReplanPlan(ctx context.Context, in *ReplanRequest, opts ...grpc.CallOption) (*PlanResponse, error)
+	GeneratePlanAlternatives(ctx context.Context, in *PlanAlternativesRequest, opts ...grpc.CallOption) (*PlanAlternativesResponse, error)
+	ExportCalendar(ctx context.Context, in *ExportCalendarRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	ExportPlan(ctx context.Context, in *ExportPlanRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	ImportTodos(ctx context.Context, in *ImportTodosRequest, opts ...grpc.CallOption) (*ImportTodosResponse, error)
+	ListStrategies(ctx context.Context, in *ListStrategiesRequest, opts ...grpc.CallOption) (*ListStrategiesResponse, error)
 }
 
 type plannerServiceClient struct {
@@ -52,12 +58,72 @@ func (c *plannerServiceClient) GetTimeConstraints(ctx context.Context, in *TimeC
 	return out, nil
 }
 
+func (c *plannerServiceClient) ReplanPlan(ctx context.Context, in *ReplanRequest, opts ...grpc.CallOption) (*PlanResponse, error) {
+	out := new(PlanResponse)
+	err := c.cc.Invoke(ctx, "/planner.PlannerService/ReplanPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *plannerServiceClient) GeneratePlanAlternatives(ctx context.Context, in *PlanAlternativesRequest, opts ...grpc.CallOption) (*PlanAlternativesResponse, error) {
+	out := new(PlanAlternativesResponse)
+	err := c.cc.Invoke(ctx, "/planner.PlannerService/GeneratePlanAlternatives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *plannerServiceClient) ExportCalendar(ctx context.Context, in *ExportCalendarRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, "/planner.PlannerService/ExportCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *plannerServiceClient) ExportPlan(ctx context.Context, in *ExportPlanRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, "/planner.PlannerService/ExportPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *plannerServiceClient) ImportTodos(ctx context.Context, in *ImportTodosRequest, opts ...grpc.CallOption) (*ImportTodosResponse, error) {
+	out := new(ImportTodosResponse)
+	err := c.cc.Invoke(ctx, "/planner.PlannerService/ImportTodos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *plannerServiceClient) ListStrategies(ctx context.Context, in *ListStrategiesRequest, opts ...grpc.CallOption) (*ListStrategiesResponse, error) {
+	out := new(ListStrategiesResponse)
+	err := c.cc.Invoke(ctx, "/planner.PlannerService/ListStrategies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PlannerServiceServer is the server API for PlannerService service.
 // All implementations must embed UnimplementedPlannerServiceServer
 // for forward compatibility
 type PlannerServiceServer interface {
 	GeneratePlan(context.Context, *PlanRequest) (*PlanResponse, error)
 	GetTimeConstraints(context.Context, *TimeConstraintsRequest) (*TimeConstraintsResponse, error)
+	ReplanPlan(context.Context, *ReplanRequest) (*PlanResponse, error)
+	GeneratePlanAlternatives(context.Context, *PlanAlternativesRequest) (*PlanAlternativesResponse, error)
+	ExportCalendar(context.Context, *ExportCalendarRequest) (*ExportResponse, error)
+	ExportPlan(context.Context, *ExportPlanRequest) (*ExportResponse, error)
+	ImportTodos(context.Context, *ImportTodosRequest) (*ImportTodosResponse, error)
+	ListStrategies(context.Context, *ListStrategiesRequest) (*ListStrategiesResponse, error)
 	mustEmbedUnimplementedPlannerServiceServer()
 }
 
@@ -71,6 +137,24 @@ func (UnimplementedPlannerServiceServer) GeneratePlan(context.Context, *PlanRequ
 func (UnimplementedPlannerServiceServer) GetTimeConstraints(context.Context, *TimeConstraintsRequest) (*TimeConstraintsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTimeConstraints not implemented")
 }
+func (UnimplementedPlannerServiceServer) ReplanPlan(context.Context, *ReplanRequest) (*PlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplanPlan not implemented")
+}
+func (UnimplementedPlannerServiceServer) GeneratePlanAlternatives(context.Context, *PlanAlternativesRequest) (*PlanAlternativesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneratePlanAlternatives not implemented")
+}
+func (UnimplementedPlannerServiceServer) ExportCalendar(context.Context, *ExportCalendarRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCalendar not implemented")
+}
+func (UnimplementedPlannerServiceServer) ExportPlan(context.Context, *ExportPlanRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPlan not implemented")
+}
+func (UnimplementedPlannerServiceServer) ImportTodos(context.Context, *ImportTodosRequest) (*ImportTodosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTodos not implemented")
+}
+func (UnimplementedPlannerServiceServer) ListStrategies(context.Context, *ListStrategiesRequest) (*ListStrategiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStrategies not implemented")
+}
 func (UnimplementedPlannerServiceServer) mustEmbedUnimplementedPlannerServiceServer() {}
 
 // UnsafePlannerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +204,114 @@ func _PlannerService_GetTimeConstraints_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlannerService_ReplanPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlannerServiceServer).ReplanPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planner.PlannerService/ReplanPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlannerServiceServer).ReplanPlan(ctx, req.(*ReplanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlannerService_GeneratePlanAlternatives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanAlternativesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlannerServiceServer).GeneratePlanAlternatives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planner.PlannerService/GeneratePlanAlternatives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlannerServiceServer).GeneratePlanAlternatives(ctx, req.(*PlanAlternativesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlannerService_ExportCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlannerServiceServer).ExportCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planner.PlannerService/ExportCalendar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlannerServiceServer).ExportCalendar(ctx, req.(*ExportCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlannerService_ExportPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlannerServiceServer).ExportPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planner.PlannerService/ExportPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlannerServiceServer).ExportPlan(ctx, req.(*ExportPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlannerService_ImportTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTodosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlannerServiceServer).ImportTodos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planner.PlannerService/ImportTodos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlannerServiceServer).ImportTodos(ctx, req.(*ImportTodosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlannerService_ListStrategies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStrategiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlannerServiceServer).ListStrategies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/planner.PlannerService/ListStrategies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlannerServiceServer).ListStrategies(ctx, req.(*ListStrategiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PlannerService_ServiceDesc is the grpc.ServiceDesc for PlannerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +327,30 @@ var PlannerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTimeConstraints",
 			Handler:    _PlannerService_GetTimeConstraints_Handler,
 		},
+		{
+			MethodName: "ReplanPlan",
+			Handler:    _PlannerService_ReplanPlan_Handler,
+		},
+		{
+			MethodName: "GeneratePlanAlternatives",
+			Handler:    _PlannerService_GeneratePlanAlternatives_Handler,
+		},
+		{
+			MethodName: "ExportCalendar",
+			Handler:    _PlannerService_ExportCalendar_Handler,
+		},
+		{
+			MethodName: "ExportPlan",
+			Handler:    _PlannerService_ExportPlan_Handler,
+		},
+		{
+			MethodName: "ImportTodos",
+			Handler:    _PlannerService_ImportTodos_Handler,
+		},
+		{
+			MethodName: "ListStrategies",
+			Handler:    _PlannerService_ListStrategies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/planner.proto",
@@ -0,0 +1,167 @@
+// Package units measures the blocks and periods of plans. A unit is a
+// whole number of a calendar unit (minute, hour, day, week or month), like
+// an hour, a 25 minute pomodoro or a 90 minute study session.
+package units
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Unit is a length of time, the zero Unit is no unit at all
+type Unit struct {
+	count int
+	base  base
+}
+
+// base is a calendar unit, units of days and longer keep the time of day
+// across DST changes and units of months keep the day of the month
+type base int
+
+const (
+	minute base = iota + 1
+	hour
+	day
+	week
+	month
+)
+
+var baseNames = map[base]string{
+	minute: "minute",
+	hour:   "hour",
+	day:    "day",
+	week:   "week",
+	month:  "month",
+}
+
+// baseMinutes holds the length of every calendar unit in minutes, a month counts as 30 days
+var baseMinutes = map[base]int{
+	minute: 1,
+	hour:   60,
+	day:    1440,
+	week:   10080,
+	month:  43200,
+}
+
+// The calendar units, other units are a number of them (Minute.Times(25))
+var (
+	Minute = Unit{1, minute}
+	Hour   = Unit{1, hour}
+	Day    = Unit{1, day}
+	Week   = Unit{1, week}
+	Month  = Unit{1, month}
+)
+
+// unitPattern matches a unit written as a number of a calendar unit, like "25 minutes"
+var unitPattern = regexp.MustCompile(`^(?:(\d+)\s*)?(minute|hour|day|week|month)s?$`)
+
+// Parse reads a unit written as a calendar unit ("hour") or as a number of
+// them ("25 minutes", "90 minutes", "2 weeks")
+func Parse(value string) (Unit, error) {
+	match := unitPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(value)))
+	if match == nil {
+		return Unit{}, fmt.Errorf("%q is not a unit (minute, hour, day, week, month or a number of them)", value)
+	}
+
+	count := 1
+	if match[1] != "" {
+		n, err := strconv.Atoi(match[1])
+		if err != nil || n < 1 {
+			return Unit{}, fmt.Errorf("%q is not a positive number of %ss", value, match[2])
+		}
+		count = n
+	}
+
+	for b, name := range baseNames {
+		if name == match[2] {
+			return Unit{count, b}, nil
+		}
+	}
+	return Unit{}, fmt.Errorf("%q is not a unit", value)
+}
+
+// IsCalendar reports whether u is a single calendar unit, like an hour and unlike 90 minutes
+func (u Unit) IsCalendar() bool {
+	return u.count == 1
+}
+
+// IsZero reports whether u is no unit at all
+func (u Unit) IsZero() bool {
+	return u.count == 0
+}
+
+// Times returns a unit n times as long as u
+func (u Unit) Times(n int) Unit {
+	return Unit{u.count * n, u.base}
+}
+
+// Minutes returns the length of the unit in minutes
+func (u Unit) Minutes() int {
+	return u.count * baseMinutes[u.base]
+}
+
+// Divides reports whether a whole number of u makes up other
+func (u Unit) Divides(other Unit) bool {
+	return !u.IsZero() && !other.IsZero() && other.Minutes()%u.Minutes() == 0
+}
+
+// In returns how many whole u fit in other
+func (u Unit) In(other Unit) int {
+	if u.IsZero() {
+		return 0
+	}
+	return other.Minutes() / u.Minutes()
+}
+
+// Larger returns the shortest calendar unit longer than u, a day for an
+// hour or for 90 minutes, false when u is a month or longer
+func (u Unit) Larger() (Unit, bool) {
+	if u.IsZero() {
+		return Unit{}, false
+	}
+	for b := u.base + 1; b <= month; b++ {
+		if baseMinutes[b] > u.Minutes() {
+			return Unit{1, b}, true
+		}
+	}
+	return Unit{}, false
+}
+
+// String writes the unit as Parse reads it: "hour" or "25 minutes"
+func (u Unit) String() string {
+	if u.count == 1 {
+		return baseNames[u.base]
+	}
+	return u.Amount(1)
+}
+
+// Amount writes n of the unit in its calendar unit, like "3 hours" or "75 minutes"
+func (u Unit) Amount(n int) string {
+	total := u.count * n
+	name := baseNames[u.base]
+	if total != 1 {
+		name += "s"
+	}
+	return fmt.Sprintf("%d %s", total, name)
+}
+
+// Add returns t moved by n of the unit
+func (u Unit) Add(t time.Time, n int) time.Time {
+	n *= u.count
+	switch u.base {
+	case minute:
+		return t.Add(time.Duration(n) * time.Minute)
+	case hour:
+		return t.Add(time.Duration(n) * time.Hour)
+	case day:
+		return t.AddDate(0, 0, n)
+	case week:
+		return t.AddDate(0, 0, 7*n)
+	case month:
+		return t.AddDate(0, n, 0)
+	}
+	return t
+}
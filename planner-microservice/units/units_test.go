@@ -0,0 +1,148 @@
+package units
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Unit
+		wantErr bool
+	}{
+		{value: "hour", want: Hour},
+		{value: "Hours", want: Hour},
+		{value: " day ", want: Day},
+		{value: "MONTH", want: Month},
+		{value: "1 week", want: Week},
+		{value: "2 weeks", want: Week.Times(2)},
+		{value: "25 minutes", want: Minute.Times(25)},
+		{value: "25minute", want: Minute.Times(25)},
+		{value: "90 minutes", want: Minute.Times(90)},
+		{value: "horu", wantErr: true},
+		{value: "dya", wantErr: true},
+		{value: "hourss", wantErr: true},
+		{value: "year", wantErr: true},
+		{value: "0 hours", wantErr: true},
+		{value: "-1 hour", wantErr: true},
+		{value: "1.5 hours", wantErr: true},
+		{value: "25", wantErr: true},
+		{value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Parse(%q) error = %v, want error %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestStringParsesBack(t *testing.T) {
+	for _, u := range []Unit{Minute, Hour, Day, Week, Month, Minute.Times(25), Hour.Times(3), Week.Times(2)} {
+		got, err := Parse(u.String())
+		if err != nil || got != u {
+			t.Errorf("Parse(%q) = %v, %v, want %v", u.String(), got, err, u)
+		}
+	}
+}
+
+func TestAmount(t *testing.T) {
+	tests := []struct {
+		unit Unit
+		n    int
+		want string
+	}{
+		{unit: Hour, n: 1, want: "1 hour"},
+		{unit: Hour, n: 3, want: "3 hours"},
+		{unit: Minute.Times(25), n: 3, want: "75 minutes"},
+		{unit: Day, n: 0, want: "0 days"},
+	}
+	for _, tt := range tests {
+		if got := tt.unit.Amount(tt.n); got != tt.want {
+			t.Errorf("%v.Amount(%d) = %q, want %q", tt.unit, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestDividesAndIn(t *testing.T) {
+	tests := []struct {
+		unit, other Unit
+		divides     bool
+		in          int
+	}{
+		{unit: Hour, other: Day, divides: true, in: 24},
+		{unit: Minute.Times(30), other: Hour, divides: true, in: 2},
+		{unit: Minute.Times(25), other: Hour, divides: false, in: 2},
+		{unit: Minute.Times(90), other: Day, divides: true, in: 16},
+		{unit: Day, other: Week, divides: true, in: 7},
+		{unit: Day, other: Month, divides: true, in: 30},
+		{unit: Week, other: Month, divides: false, in: 4},
+		{unit: Day, other: Hour, divides: false, in: 0},
+		{unit: Hour, other: Unit{}, divides: false, in: 0},
+		{unit: Unit{}, other: Hour, divides: false, in: 0},
+	}
+	for _, tt := range tests {
+		if got := tt.unit.Divides(tt.other); got != tt.divides {
+			t.Errorf("%v.Divides(%v) = %v, want %v", tt.unit, tt.other, got, tt.divides)
+		}
+		if got := tt.unit.In(tt.other); got != tt.in {
+			t.Errorf("%v.In(%v) = %d, want %d", tt.unit, tt.other, got, tt.in)
+		}
+	}
+}
+
+func TestLarger(t *testing.T) {
+	tests := []struct {
+		unit Unit
+		want Unit
+		ok   bool
+	}{
+		{unit: Minute, want: Hour, ok: true},
+		{unit: Minute.Times(25), want: Hour, ok: true},
+		{unit: Minute.Times(90), want: Day, ok: true},
+		{unit: Hour, want: Day, ok: true},
+		{unit: Hour.Times(25), want: Week, ok: true},
+		{unit: Day, want: Week, ok: true},
+		{unit: Week, want: Month, ok: true},
+		{unit: Week.Times(5), ok: false},
+		{unit: Month, ok: false},
+		{unit: Unit{}, ok: false},
+	}
+	for _, tt := range tests {
+		got, ok := tt.unit.Larger()
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("%v.Larger() = %v, %v, want %v, %v", tt.unit, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestAdd(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip(err)
+	}
+	// DST starts in Berlin on 2026-03-29
+	start := time.Date(2026, 3, 28, 9, 0, 0, 0, berlin)
+	tests := []struct {
+		unit Unit
+		n    int
+		want time.Time
+	}{
+		{unit: Hour, n: 24, want: time.Date(2026, 3, 29, 10, 0, 0, 0, berlin)},
+		{unit: Day, n: 1, want: time.Date(2026, 3, 29, 9, 0, 0, 0, berlin)},
+		{unit: Minute.Times(25), n: 2, want: time.Date(2026, 3, 28, 9, 50, 0, 0, berlin)},
+		{unit: Week.Times(2), n: 1, want: time.Date(2026, 4, 11, 9, 0, 0, 0, berlin)},
+		{unit: Month, n: 1, want: time.Date(2026, 4, 28, 9, 0, 0, 0, berlin)},
+		{unit: Unit{}, n: 3, want: start},
+	}
+	for _, tt := range tests {
+		if got := tt.unit.Add(start, tt.n); !got.Equal(tt.want) {
+			t.Errorf("%v.Add(%s, %d) = %s, want %s", tt.unit, start, tt.n, got, tt.want)
+		}
+	}
+}